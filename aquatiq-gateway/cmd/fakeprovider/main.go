@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/aquatiq/integration-gateway/pkg/fakeprovider"
+)
+
+func main() {
+	addr := flag.String("addr", ":7700", "Listen address")
+	seed := flag.String("seed", "", "JSON file with initial records (see fakeprovider.SeedData)")
+	clients := flag.String("clients", "", "Comma-separated client_id:client_secret pairs; any client is accepted when empty")
+	companies := flag.String("companies", "", "Comma-separated Visma company IDs; any company is accepted when empty")
+	tokenTTL := flag.Duration("token-ttl", time.Hour, "Access token lifetime")
+	pageSize := flag.Int("page-size", 50, "SuperOffice page size when $top is not set")
+	skipAuth := flag.Bool("skip-auth", false, "Serve API calls without a bearer token")
+	flag.Parse()
+
+	fmt.Println("🧪 Aquatiq Fake Providers (SuperOffice + Visma.net) - Starting...")
+
+	cfg := fakeprovider.Config{
+		TokenTTL: *tokenTTL,
+		SkipAuth: *skipAuth,
+		PageSize: *pageSize,
+	}
+	if *clients != "" {
+		cfg.Clients = make(map[string]string)
+		for _, pair := range strings.Split(*clients, ",") {
+			id, secret, ok := strings.Cut(strings.TrimSpace(pair), ":")
+			if !ok || id == "" {
+				fmt.Printf("❌ Invalid client %q, expected client_id:client_secret\n", pair)
+				os.Exit(1)
+			}
+			cfg.Clients[id] = secret
+		}
+	}
+	if *companies != "" {
+		for _, company := range strings.Split(*companies, ",") {
+			cfg.VismaCompanies = append(cfg.VismaCompanies, strings.TrimSpace(company))
+		}
+	}
+
+	server := fakeprovider.New(cfg)
+
+	if *seed != "" {
+		if err := loadSeed(server, *seed); err != nil {
+			fmt.Printf("❌ Failed to load seed data: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("✅ Seed data loaded from %s\n", *seed)
+	}
+
+	srv := &http.Server{
+		Addr:              *addr,
+		Handler:           server,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		fmt.Printf("\n🌐 Fake providers listening on http://%s\n", *addr)
+		fmt.Println("📍 Endpoints:")
+		fmt.Println("  - ANY  /{tenant}/api/v1/{Entity}[/{id}]    - SuperOffice REST (Contact, Person, Sale, Project, Appointment)")
+		fmt.Println("  - GET  /login/common/oauth/authorize       - SuperOffice OAuth2 (auto-approves)")
+		fmt.Println("  - POST /login/common/oauth/tokens          - SuperOffice token endpoint")
+		fmt.Println("  - ANY  /controller/api/v1/{entity}[/{key}] - Visma.net REST (customer, supplier, customerinvoice, inventory, project)")
+		fmt.Println("  - GET  /connect/authorize                  - Visma OAuth2 (auto-approves)")
+		fmt.Println("  - POST /connect/token                      - Visma token endpoint")
+		fmt.Println("  - GET  /_fake/state                        - Request, token and record counts")
+		fmt.Println("  - POST /_fake/faults                       - Inject a fault, e.g. {\"provider\":\"visma\",\"status\":429,\"retry_after\":\"2s\",\"times\":3}")
+		fmt.Println("  - DELETE /_fake/faults[/{id}]              - Remove faults")
+		fmt.Println("  - POST /_fake/tokens/expire?provider=      - Expire issued access tokens")
+		fmt.Println("  - POST /_fake/tokens/revoke?provider=      - Revoke access and refresh tokens")
+		fmt.Println("  - POST /_fake/seed, /_fake/reset           - Load or drop records")
+
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			fmt.Printf("❌ Server error: %v\n", err)
+			os.Exit(1)
+		}
+	}()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	fmt.Println("\n🛑 Shutting down...")
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_ = srv.Shutdown(ctx)
+}
+
+// loadSeed reads a seed file into the server
+func loadSeed(server *fakeprovider.Server, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var seed fakeprovider.SeedData
+	if err := json.Unmarshal(data, &seed); err != nil {
+		return fmt.Errorf("invalid seed file: %w", err)
+	}
+	return server.Seed(seed)
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/aquatiq/integration-gateway/internal/audit"
+	"github.com/aquatiq/integration-gateway/internal/config"
+)
+
+// auditUsage lists the audit subcommands
+const auditUsage = `Usage:
+  gateway audit verify [-source file|postgres|archive] [-file path] [-dir path] [-public-key key] [-json]
+      Check the audit hash chain and report the first broken link.
+      Verifies every configured store when -source is not set.
+  gateway audit keygen
+      Print a new checkpoint signing key (audit.chain.signingkey) and its public key.`
+
+// runAuditCommand runs "gateway audit ..." and returns the exit code:
+// 0 when every chain is intact, 1 when a link is broken, 2 on errors
+func runAuditCommand(args []string) int {
+	if len(args) == 0 {
+		fmt.Println(auditUsage)
+		return 2
+	}
+
+	switch args[0] {
+	case "verify":
+		return runAuditVerify(args[1:])
+	case "keygen":
+		return runAuditKeygen()
+	default:
+		fmt.Println(auditUsage)
+		return 2
+	}
+}
+
+// runAuditVerify verifies the hash chain of the configured audit stores
+func runAuditVerify(args []string) int {
+	flags := flag.NewFlagSet("audit verify", flag.ContinueOnError)
+	source := flags.String("source", "", "Store to verify: file, postgres or archive; every configured store when empty")
+	file := flags.String("file", "", "Audit file; defaults to audit.file.path")
+	dir := flags.String("dir", "", "Archive directory; defaults to audit.retention.archive.dir")
+	publicKey := flags.String("public-key", "", "Base64 Ed25519 public key checking checkpoints; derived from audit.chain.signingkey when empty")
+	asJSON := flags.Bool("json", false, "Print the reports as JSON")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Printf("❌ Failed to load configuration: %v\n", err)
+		return 2
+	}
+
+	var key ed25519.PublicKey
+	switch {
+	case *publicKey != "":
+		if key, err = audit.ParsePublicKey(*publicKey); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			return 2
+		}
+	case cfg.Audit.Chain.SigningKey != "":
+		signingKey, err := audit.ParseSigningKey(cfg.Audit.Chain.SigningKey)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			return 2
+		}
+		key = signingKey.Public().(ed25519.PublicKey)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+
+	// Every configured store unless one was named
+	sources := []string{*source}
+	if *source == "" {
+		sources = nil
+		if cfg.Audit.File.Enabled || *file != "" {
+			sources = append(sources, "file")
+		}
+		if cfg.Audit.Postgres.Enabled {
+			sources = append(sources, "postgres")
+		}
+		if cfg.Audit.Retention.Archive.Enabled || *dir != "" {
+			sources = append(sources, "archive")
+		}
+		if len(sources) == 0 {
+			fmt.Println("❌ No audit store configured; set audit.file or audit.postgres, or pass -file or -dir")
+			return 2
+		}
+	}
+
+	opts := audit.VerifyOptions{PublicKey: key, MinRetention: auditLifecycleConfig(cfg).MinRetention()}
+	code := 0
+	for _, name := range sources {
+		report, err := verifyAuditStore(ctx, cfg, name, *file, *dir, opts)
+		if err != nil {
+			fmt.Printf("❌ %s: %v\n", name, err)
+			code = 2
+			continue
+		}
+		if !report.OK && code == 0 {
+			code = 1
+		}
+		printVerifyReport(report, *asJSON)
+	}
+	return code
+}
+
+// verifyAuditStore opens a store read-only and verifies it
+func verifyAuditStore(ctx context.Context, cfg *config.Config, source, file, dir string, opts audit.VerifyOptions) (*audit.VerifyReport, error) {
+	switch source {
+	case "file":
+		if file == "" {
+			file = cfg.Audit.File.Path
+		}
+		opts.Sink = "file"
+		return audit.Verify(ctx, file, audit.NewFileStore(file), opts)
+	case "postgres":
+		if cfg.Database.PostgresURL == "" {
+			return nil, fmt.Errorf("database.postgres_url is required")
+		}
+		store, err := audit.NewPostgresSink(ctx, cfg.Database.PostgresURL)
+		if err != nil {
+			return nil, err
+		}
+		defer store.Close()
+		opts.Sink = "postgres"
+		return audit.Verify(ctx, "postgres", store, opts)
+	case "archive":
+		if dir == "" {
+			dir = cfg.Audit.Retention.Archive.Dir
+		}
+		// Archives hold events moved out of Postgres
+		opts.Sink = "postgres"
+		return audit.Verify(ctx, dir, audit.NewArchiveStore(dir), opts)
+	default:
+		return nil, fmt.Errorf("unknown source, expected file, postgres or archive")
+	}
+}
+
+// printVerifyReport prints a verification report
+func printVerifyReport(report *audit.VerifyReport, asJSON bool) {
+	if asJSON {
+		data, _ := json.MarshalIndent(report, "", "  ")
+		fmt.Println(string(data))
+		return
+	}
+
+	if report.OK {
+		fmt.Printf("✅ %s: %d events intact\n", report.Source, report.Events)
+	} else {
+		fmt.Printf("❌ %s: broken link after %d intact events\n", report.Source, report.Events)
+		fmt.Printf("   chain %s, event %d at %s: %s\n", report.Broken.Chain, report.Broken.Seq, report.Broken.Position, report.Broken.Reason)
+	}
+	for _, c := range report.Chains {
+		fmt.Printf("   chain %s: events %d-%d, head %s\n", c.ID, c.FirstSeq, c.LastSeq, c.LastHash)
+	}
+	fmt.Printf("   checkpoints: %d signed and valid, %d not checked\n", report.Checkpoints, report.Unverified)
+	if report.Unchained > 0 {
+		fmt.Printf("   %d events from before chaining was enabled\n", report.Unchained)
+	}
+	if report.Pruned > 0 {
+		fmt.Printf("   %d events pruned by retention; their links and age were checked\n", report.Pruned)
+	}
+	if report.Dropped > 0 {
+		fmt.Printf("   %d events lost by the sink, accounted for by dropped markers\n", report.Dropped)
+	}
+}
+
+// runAuditKeygen prints a new checkpoint signing key
+func runAuditKeygen() int {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		fmt.Printf("❌ Failed to generate key: %v\n", err)
+		return 2
+	}
+	fmt.Printf("AUDIT_SIGNING_KEY=%s\n", base64.StdEncoding.EncodeToString(privateKey.Seed()))
+	fmt.Printf("Public key (for audit verify -public-key): %s\n", base64.StdEncoding.EncodeToString(publicKey))
+	fmt.Printf("Key ID: %s\n", audit.KeyID(publicKey))
+	return 0
+}
@@ -2,29 +2,51 @@ package main
 
 import (
 	"context"
+	"crypto/ed25519"
 	"encoding/json"
 	"fmt"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/aquatiq/integration-gateway/internal/audit"
+	"github.com/aquatiq/integration-gateway/internal/auth"
 	"github.com/aquatiq/integration-gateway/internal/cache"
 	"github.com/aquatiq/integration-gateway/internal/config"
+	"github.com/aquatiq/integration-gateway/internal/customersync"
+	"github.com/aquatiq/integration-gateway/internal/deadletter"
 	"github.com/aquatiq/integration-gateway/internal/docker"
 	"github.com/aquatiq/integration-gateway/internal/grpc"
 	"github.com/aquatiq/integration-gateway/internal/health"
+	"github.com/aquatiq/integration-gateway/internal/idempotency"
+	"github.com/aquatiq/integration-gateway/internal/integrations"
+	"github.com/aquatiq/integration-gateway/internal/integrations/superoffice"
+	"github.com/aquatiq/integration-gateway/internal/integrations/visma"
+	"github.com/aquatiq/integration-gateway/internal/messaging"
+	"github.com/aquatiq/integration-gateway/internal/oauth"
+	"github.com/aquatiq/integration-gateway/internal/proxy"
 	"github.com/aquatiq/integration-gateway/internal/ratelimit"
+	"github.com/aquatiq/integration-gateway/internal/schema"
+	"github.com/aquatiq/integration-gateway/internal/webhooks"
 	"github.com/aquatiq/integration-gateway/internal/whitelist"
+	"github.com/aquatiq/integration-gateway/pkg/circuitbreaker"
+	"github.com/aquatiq/integration-gateway/pkg/httpclient"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 
+	auditv1 "github.com/aquatiq/integration-gateway/api/proto/audit/v1"
 	databasev1 "github.com/aquatiq/integration-gateway/api/proto/database/v1"
+	deadletterv1 "github.com/aquatiq/integration-gateway/api/proto/deadletter/v1"
 	dockerv1 "github.com/aquatiq/integration-gateway/api/proto/docker/v1"
 	healthv1 "github.com/aquatiq/integration-gateway/api/proto/health/v1"
+	integrationv1 "github.com/aquatiq/integration-gateway/api/proto/integration/v1"
+	superofficev1 "github.com/aquatiq/integration-gateway/api/proto/superoffice/v1"
+	syncv1 "github.com/aquatiq/integration-gateway/api/proto/sync/v1"
+	vismav1 "github.com/aquatiq/integration-gateway/api/proto/visma/v1"
 	whitelistv1 "github.com/aquatiq/integration-gateway/api/proto/whitelist/v1"
 	grpcServer "google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -32,6 +54,11 @@ import (
 )
 
 func main() {
+	// Subcommands run instead of the gateway
+	if len(os.Args) > 1 && os.Args[1] == "audit" {
+		os.Exit(runAuditCommand(os.Args[2:]))
+	}
+
 	fmt.Println("🚀 Aquatiq Integration Gateway - Starting...")
 
 	// Initialize audit logger
@@ -55,6 +82,20 @@ func main() {
 		fmt.Println("✅ Configuration loaded")
 	}
 
+	// Persistent audit sinks (the JetStream sink is added once NATS is connected)
+	auditLogger.SetStdout(cfg.Audit.Stdout)
+	if err := auditLogger.SetIPMasking(audit.IPMaskConfig{
+		Mode:       cfg.Audit.IPMasking.Mode,
+		IPv4Prefix: cfg.Audit.IPMasking.IPv4Prefix,
+		IPv6Prefix: cfg.Audit.IPMasking.IPv6Prefix,
+		HMACKey:    []byte(cfg.Audit.IPMasking.HMACKey),
+	}); err != nil {
+		fmt.Printf("⚠️  Audit IP masking falls back to /24 and /48 prefixes: %v\n", err)
+	}
+	addAuditSinks(cfg, auditLogger)
+	auditPublicKey := enableAuditChain(cfg, auditLogger)
+	auditLifecycle := startAuditLifecycle(cfg, auditLogger)
+
 	// Initialize Redis cache (optional - graceful degradation)
 	var redisCache *cache.RedisCache
 	if cfg.Redis.Enabled {
@@ -81,7 +122,236 @@ func main() {
 	})
 	fmt.Println("✅ Rate limiter initialized")
 
+	// Token manager for integration OAuth2 tokens
+	tokenManager := auth.NewTokenManager(auth.TokenManagerConfig{
+		Cache:           redisCache,
+		EncryptionKey:   cfg.OAuth.EncryptionKey,
+		RefreshInterval: cfg.Auth.TokenRefreshInterval,
+		AuditLogger:     auditLogger,
+	})
+	if so := cfg.Integrations.SuperOffice; so.ClientID != "" {
+		tokenManager.RegisterProvider(auth.ProviderConfig{
+			Name:         "superoffice",
+			TokenURL:     so.TokenURL,
+			ClientID:     so.ClientID,
+			ClientSecret: so.ClientSecret,
+			Scopes:       so.Scopes,
+		})
+	}
+	if visma := cfg.Integrations.Visma; visma.ClientID != "" {
+		tokenManager.RegisterProvider(auth.ProviderConfig{
+			Name:         "visma",
+			TokenURL:     visma.TokenURL,
+			ClientID:     visma.ClientID,
+			ClientSecret: visma.ClientSecret,
+			Scopes:       visma.Scopes,
+		})
+	}
+
+	// OAuth2 authorization-code flow for connecting providers
+	oauthHandler := oauth.New(oauth.Config{
+		Providers:   cfg.OAuth.Providers,
+		StateTTL:    cfg.OAuth.StateTTL,
+		Tokens:      tokenManager,
+		Cache:       redisCache,
+		AuditLogger: auditLogger,
+	})
+	tokenCtx, stopTokenRefresh := context.WithCancel(context.Background())
+	defer stopTokenRefresh()
+	go tokenManager.Start(tokenCtx)
+	fmt.Println("✅ Token manager initialized")
+	if providers := oauthHandler.Enabled(); len(providers) > 0 {
+		fmt.Printf("✅ OAuth authorization enabled for: %s\n", strings.Join(providers, ", "))
+	}
+
+	// Outbound request logging shared by all integration clients
+	outboundLogging := httpclient.NewLogSettings(httpclient.LogConfig{
+		Enabled:       cfg.Logging.Outbound.Enabled,
+		SampleRate:    cfg.Logging.Outbound.SampleRate,
+		LogBodies:     cfg.Logging.Outbound.LogBodies,
+		MaxBodyBytes:  cfg.Logging.Outbound.MaxBodyBytes,
+		RedactHeaders: cfg.Logging.Outbound.RedactHeaders,
+		RedactFields:  cfg.Logging.Outbound.RedactFields,
+		Debug:         cfg.Logging.Outbound.Debug,
+	})
+
 	// Create circuit breakers for each integration
+	breakers := circuitbreaker.NewManager()
+	integrationDeps := integrations.Dependencies{
+		Tokens:         tokenManager,
+		AuditLogger:    auditLogger,
+		Logging:        outboundLogging,
+		CircuitBreaker: cfg.CircuitBreaker,
+		Breakers:       breakers,
+	}
+	if redisCache != nil {
+		// Provider quotas are shared by all replicas
+		integrationDeps.Quotas = cache.NewQuotaStore(redisCache)
+		// GET responses are cached per tenant and company
+		integrationDeps.Cache = cache.NewQueryCache(cache.QueryCacheConfig{Redis: redisCache, Prefix: "integration"})
+	}
+
+	// Dead-letter queue for writes that fail after all retries
+	var deadLetters *deadletter.Queue
+	if cfg.DeadLetter.Enabled {
+		deadLetters, err = newDeadLetterQueue(cfg, auditLogger)
+		if err != nil {
+			fmt.Printf("⚠️  Dead-letter queue disabled: %v\n", err)
+			deadLetters = nil
+		} else {
+			integrationDeps.DeadLetters = deadLetters
+			defer deadLetters.Close()
+			fmt.Printf("✅ Dead-letter queue initialized (store: %s)\n", cfg.DeadLetter.Store)
+		}
+	}
+
+	// JSON Schema validation of integration payloads and canonical mappings
+	var schemaRegistry *schema.Registry
+	if cfg.Schemas.Enabled {
+		schemaRegistry, err = schema.New(schema.Config{
+			Mode:        cfg.Schemas.Mode,
+			Dir:         cfg.Schemas.Dir,
+			Versions:    cfg.Schemas.Versions,
+			AuditLogger: auditLogger,
+		})
+		if err != nil {
+			fmt.Printf("⚠️  Schema validation disabled: %v\n", err)
+			schemaRegistry = nil
+		} else {
+			integrationDeps.Validator = schemaRegistry
+			fmt.Printf("✅ Schema validation enabled (%d schemas, mode: %s)\n", len(schemaRegistry.Schemas()), cfg.Schemas.Mode)
+		}
+	}
+
+	// SuperOffice client
+	superOfficeClient, err := superoffice.New(cfg.Integrations.SuperOffice, integrationDeps)
+	if err != nil {
+		fmt.Printf("⚠️  SuperOffice client disabled: %v\n", err)
+		superOfficeClient = nil
+	} else {
+		fmt.Println("✅ SuperOffice client initialized")
+	}
+
+	// Visma.net client
+	vismaClient, err := visma.New(cfg.Integrations.Visma, integrationDeps)
+	if err != nil {
+		fmt.Printf("⚠️  Visma client disabled: %v\n", err)
+		vismaClient = nil
+	} else {
+		fmt.Println("✅ Visma client initialized")
+	}
+
+	// Retry dead letters through the clients once their breakers close
+	if deadLetters != nil {
+		if superOfficeClient != nil {
+			deadLetters.Register(superoffice.ServiceName, superOfficeClient.HTTP())
+		}
+		if vismaClient != nil {
+			deadLetters.Register(visma.ServiceName, vismaClient.HTTP())
+		}
+		deadLetterCtx, stopDeadLetters := context.WithCancel(context.Background())
+		defer stopDeadLetters()
+		go deadLetters.Start(deadLetterCtx)
+	}
+
+	// API keys for scoped endpoints
+	apiKeys := make([]auth.APIKey, 0, len(cfg.Auth.APIKeys))
+	for _, key := range cfg.Auth.APIKeys {
+		apiKeys = append(apiKeys, auth.APIKey{
+			Key:       key.Key,
+			Name:      key.Name,
+			CreatedAt: time.Now(),
+			Scopes:    key.Scopes,
+			Enabled:   key.Enabled && key.Key != "",
+		})
+	}
+	apiKeyAuth := auth.NewAPIKeyAuthenticator(auth.Config{
+		Keys:        apiKeys,
+		AuditLogger: auditLogger,
+	})
+
+	// Generic integration proxy
+	proxyTargets := make(map[string]proxy.Target)
+	if superOfficeClient != nil {
+		proxyTargets[superoffice.ServiceName] = proxy.Target{BaseURL: superOfficeClient.BaseURL(), Client: superOfficeClient.HTTP()}
+	}
+	if vismaClient != nil {
+		proxyTargets[visma.ServiceName] = proxy.Target{BaseURL: vismaClient.BaseURL(), Client: vismaClient.HTTP()}
+	}
+	integrationProxy := proxy.New(proxy.Config{
+		Targets:      proxyTargets,
+		Rules:        cfg.Proxy.Rules,
+		MaxBodyBytes: cfg.Proxy.MaxBodyBytes,
+		AuditLogger:  auditLogger,
+	})
+	if cfg.Proxy.Enabled {
+		fmt.Printf("✅ Integration proxy enabled for: %s (%d rules)\n", strings.Join(integrationProxy.Services(), ", "), len(cfg.Proxy.Rules))
+	}
+
+	// SuperOffice ↔ Visma customer sync
+	var syncEngine *customersync.Engine
+	if cfg.Sync.Enabled {
+		syncEngine, err = newSyncEngine(cfg, superOfficeClient, vismaClient, auditLogger)
+		if err != nil {
+			fmt.Printf("⚠️  Customer sync disabled: %v\n", err)
+			syncEngine = nil
+		} else {
+			syncCtx, stopSync := context.WithCancel(context.Background())
+			defer stopSync()
+			go syncEngine.Start(syncCtx)
+			fmt.Printf("✅ Customer sync enabled (%s every %s)\n", cfg.Sync.Direction, cfg.Sync.Interval)
+		}
+	}
+
+	// NATS JetStream
+	var jetStream *messaging.JetStream
+	if cfg.NATS.Enabled {
+		jetStream, err = messaging.Connect(cfg.NATS)
+		if err != nil {
+			fmt.Printf("⚠️  NATS unavailable: %v\n", err)
+			jetStream = nil
+		} else {
+			fmt.Println("✅ NATS JetStream connected")
+			defer jetStream.Close()
+		}
+	}
+	if jetStream != nil && cfg.Audit.NATS.Enabled {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		sink, err := audit.NewJetStreamSink(ctx, jetStream, cfg.Audit.NATS.Stream, cfg.Audit.NATS.Subject, cfg.Audit.NATS.Retention)
+		cancel()
+		if err != nil {
+			fmt.Printf("⚠️  Audit JetStream sink disabled: %v\n", err)
+		} else {
+			auditLogger.AddSink(sink, auditSinkOptions(cfg))
+			fmt.Printf("✅ Audit events published to %s\n", cfg.Audit.NATS.Subject)
+		}
+	}
+
+	// Provider webhooks
+	var webhookHandler *webhooks.Handler
+	if cfg.Webhooks.Enabled {
+		webhookHandler, err = newWebhookHandler(cfg, jetStream, redisCache, auditLogger)
+		if err != nil {
+			fmt.Printf("⚠️  Webhooks disabled: %v\n", err)
+			webhookHandler = nil
+		} else {
+			// Changed entities are dropped from the response cache
+			webhookHandler.OnEvent(func(event webhooks.Event) {
+				go invalidateFromWebhook(event, superOfficeClient, vismaClient, auditLogger)
+			})
+			// Contact and customer changes start a sync right away instead of at the next interval
+			if syncEngine != nil {
+				webhookHandler.OnEvent(func(event webhooks.Event) {
+					eventType := strings.ToLower(event.Type)
+					if strings.Contains(eventType, "contact") || strings.Contains(eventType, "customer") {
+						syncEngine.Notify()
+					}
+				})
+			}
+			fmt.Printf("✅ Webhooks enabled for: %s (queue: %s)\n", strings.Join(webhookHandler.Enabled(), ", "), cfg.Webhooks.Queue)
+		}
+	}
+
 	// Initialize managers for gRPC services
 
 	// Docker manager
@@ -125,7 +395,11 @@ func main() {
 
 	// Global middleware
 	r.Use(middleware.RequestID)
+	r.Use(audit.RequestIDMiddleware)
 	r.Use(middleware.RealIP)
+	if cfg.Audit.Requests.Enabled {
+		r.Use(auditLogger.Middleware(cfg.Audit.Requests.SkipPaths...))
+	}
 	r.Use(middleware.Logger)
 	r.Use(middleware.Recoverer)
 	r.Use(middleware.Compress(5)) // Add gzip compression (level 5 = good balance)
@@ -134,6 +408,20 @@ func main() {
 	// Apply rate limiting to all routes
 	r.Use(rateLimiter.Middleware("global"))
 
+	// Make write endpoints safe to retry with Idempotency-Key. It is mounted
+	// after the API key and scope checks of each route, so a revoked key or a
+	// lost scope is never answered with a stored response.
+	idempotent := func(next http.Handler) http.Handler { return next }
+	if cfg.Idempotency.Enabled && redisCache != nil {
+		idempotent = idempotency.New(idempotency.Config{
+			Cache:        redisCache,
+			TTL:          cfg.Idempotency.TTL,
+			LockTTL:      cfg.Idempotency.LockTTL,
+			MaxBodyBytes: cfg.Idempotency.MaxBodyBytes,
+			AuditLogger:  auditLogger,
+		}).Middleware
+	}
+
 	// Public endpoints
 	r.Get("/health", func(w http.ResponseWriter, r *http.Request) {
 		health := map[string]interface{}{
@@ -166,6 +454,66 @@ func main() {
 		json.NewEncoder(w).Encode(health)
 	})
 
+	// OAuth2 authorization-code flow. Authorizing needs oauth:connect and
+	// returns a single-use start link for the user's browser; the provider's
+	// redirect to the callback is tied to that browser by a cookie.
+	r.Group(func(r chi.Router) {
+		r.Use(apiKeyAuth.Middleware)
+		r.Use(apiKeyAuth.RequireScopes("oauth:connect"))
+		r.Get("/oauth/{provider}/authorize", oauthHandler.Authorize)
+	})
+	r.Get("/oauth/{provider}/start", oauthHandler.Start)
+	r.Get("/oauth/{provider}/callback", oauthHandler.Callback)
+
+	// Provider webhooks (authenticated by signature or shared secret, so no API key)
+	if webhookHandler != nil {
+		r.Post("/webhooks/{provider}", webhookHandler.Receive)
+	}
+
+	// Integration proxy (API key scopes decide which paths and methods are allowed)
+	if cfg.Proxy.Enabled {
+		r.Group(func(r chi.Router) {
+			r.Use(apiKeyAuth.Middleware)
+			r.Use(integrationProxy.Authorize)
+			r.Use(idempotent)
+			r.Handle("/integrations/{service}/*", integrationProxy)
+		})
+	}
+
+	// Payload schemas and canonical mappings (schemas:read)
+	if schemaRegistry != nil {
+		schemaHandler := schema.NewHandler(schemaRegistry, auditLogger)
+		r.Route("/schemas", func(r chi.Router) {
+			r.Use(apiKeyAuth.Middleware)
+			r.Use(apiKeyAuth.RequireScopes("schemas:read"))
+			r.Get("/", schemaHandler.List)
+			r.Get("/stats", schemaHandler.Stats)
+			r.Get("/{provider}/{name}", schemaHandler.Get)
+			r.With(idempotent).Post("/map", schemaHandler.Map)
+		})
+	}
+
+	// Dead letters (read with deadletters:read, replay/edit/discard with deadletters:write)
+	if deadLetters != nil {
+		deadLetterHandler := deadletter.NewHandler(deadLetters, auditLogger)
+		r.Route("/deadletters", func(r chi.Router) {
+			r.Use(apiKeyAuth.Middleware)
+			r.Group(func(r chi.Router) {
+				r.Use(apiKeyAuth.RequireScopes("deadletters:read"))
+				r.Get("/", deadLetterHandler.List)
+				r.Get("/stats", deadLetterHandler.Stats)
+				r.Get("/{id}", deadLetterHandler.Get)
+			})
+			r.Group(func(r chi.Router) {
+				r.Use(apiKeyAuth.RequireScopes("deadletters:write"))
+				r.Use(idempotent)
+				r.Post("/{id}/replay", deadLetterHandler.Replay)
+				r.Put("/{id}", deadLetterHandler.Edit)
+				r.Post("/{id}/discard", deadLetterHandler.Discard)
+			})
+		})
+	}
+
 	// Admin endpoints (with stricter rate limiting)
 	r.Group(func(r chi.Router) {
 		r.Use(rateLimiter.Middleware("admin"))
@@ -187,17 +535,85 @@ func main() {
 				return
 			}
 
+			integrationStats := make(map[string]integrations.CacheStats)
+			if superOfficeClient != nil {
+				integrationStats[superoffice.ServiceName] = superOfficeClient.CacheStats()
+			}
+			if vismaClient != nil {
+				integrationStats[visma.ServiceName] = vismaClient.CacheStats()
+			}
+
 			stats := redisCache.PoolStats()
 			w.Header().Set("Content-Type", "application/json")
 			json.NewEncoder(w).Encode(map[string]interface{}{
-				"total_conns": stats.TotalConns,
-				"idle_conns":  stats.IdleConns,
-				"stale_conns": stats.StaleConns,
-				"hits":        stats.Hits,
-				"misses":      stats.Misses,
-				"timeouts":    stats.Timeouts,
+				"total_conns":  stats.TotalConns,
+				"idle_conns":   stats.IdleConns,
+				"stale_conns":  stats.StaleConns,
+				"hits":         stats.Hits,
+				"misses":       stats.Misses,
+				"timeouts":     stats.Timeouts,
+				"integrations": integrationStats,
 			})
 		})
+
+		// Audit sink buffers and failures
+		r.Get("/audit/sinks", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(auditLogger.SinkStats())
+		})
+
+		// Connected OAuth providers and tenants (oauth:read)
+		r.With(apiKeyAuth.Middleware, apiKeyAuth.RequireScopes("oauth:read")).Get("/oauth/status", oauthHandler.Status)
+
+		// Integration client stats (bulkhead, rate limit and remaining quota)
+		r.Get("/integrations/stats", func(w http.ResponseWriter, r *http.Request) {
+			stats := make(map[string]httpclient.Stats)
+			if superOfficeClient != nil {
+				stats[superoffice.ServiceName] = superOfficeClient.Stats()
+			}
+			if vismaClient != nil {
+				stats[visma.ServiceName] = vismaClient.Stats()
+			}
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(stats)
+		})
+
+		// Outbound logging settings
+		r.Get("/integrations/logging", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(outboundLogging.Snapshot())
+		})
+
+		// Change sampling and debug toggles at runtime (debug logs bodies, so logging:write)
+		r.With(apiKeyAuth.Middleware, apiKeyAuth.RequireScopes("logging:write"), idempotent).Put("/integrations/logging", func(w http.ResponseWriter, r *http.Request) {
+			var update struct {
+				Enabled    *bool           `json:"enabled"`
+				SampleRate *float64        `json:"sample_rate"`
+				Debug      map[string]bool `json:"debug"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				json.NewEncoder(w).Encode(map[string]string{
+					"error": "invalid request body",
+				})
+				return
+			}
+
+			if update.Enabled != nil {
+				outboundLogging.SetEnabled(*update.Enabled)
+			}
+			if update.SampleRate != nil {
+				outboundLogging.SetSampleRate(*update.SampleRate)
+			}
+			for service, debug := range update.Debug {
+				outboundLogging.SetDebug(service, debug)
+			}
+			auditLogger.LogHTTPRequest(r, "outbound_logging_updated", true, nil, 0)
+
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(outboundLogging.Snapshot())
+		})
 	})
 
 	// Start HTTP REST server
@@ -216,6 +632,28 @@ func main() {
 		fmt.Println("  - GET  /health              - Health check")
 		fmt.Println("  - GET  /rate-limiter        - Rate limiter stats (admin)")
 		fmt.Println("  - GET  /cache/stats         - Redis cache stats (admin)")
+		fmt.Println("  - GET  /oauth/{provider}/authorize - Start link to connect SuperOffice/Visma (API key, oauth:connect)")
+		fmt.Println("  - GET  /oauth/{provider}/start     - Single-use start link, redirects to the provider (PKCE)")
+		fmt.Println("  - GET  /oauth/{provider}/callback  - OAuth redirect target")
+		fmt.Println("  - GET  /oauth/status        - Connected OAuth providers (admin, API key, oauth:read)")
+		fmt.Println("  - GET  /audit/sinks         - Audit sink buffers and failures (admin)")
+		if webhookHandler != nil {
+			fmt.Println("  - POST /webhooks/{provider} - SuperOffice/Visma event deliveries (signed)")
+		}
+		if cfg.Proxy.Enabled {
+			fmt.Println("  - ANY  /integrations/{service}/* - Provider API proxy (API key scopes)")
+		}
+		if deadLetters != nil {
+			fmt.Println("  - GET  /deadletters[/{id}]  - Failed integration writes (API key, deadletters:read)")
+			fmt.Println("  - POST /deadletters/{id}/replay|discard, PUT /deadletters/{id} - Replay, discard or edit (deadletters:write)")
+		}
+		if schemaRegistry != nil {
+			fmt.Println("  - GET  /schemas[/stats|/{provider}/{name}] - Payload schemas and validation stats (API key, schemas:read)")
+			fmt.Println("  - POST /schemas/map?source=&target= - Map provider payloads to canonical models (schemas:read)")
+		}
+		fmt.Println("  - GET  /integrations/stats  - Integration client stats and remaining quota (admin)")
+		fmt.Println("  - GET  /integrations/logging - Outbound logging settings (admin)")
+		fmt.Println("  - PUT  /integrations/logging - Change sampling/debug at runtime (API key, logging:write)")
 
 		if err := restSrv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 			fmt.Printf("❌ REST server error: %v\n", err)
@@ -254,6 +692,50 @@ func main() {
 		fmt.Println("⚠️  gRPC TLS disabled - using plaintext (not recommended for production)")
 	}
 
+	// API key scopes of the gRPC services that require them
+	grpcScopes := map[string][]string{
+		"/" + auditv1.AuditService_ServiceDesc.ServiceName + "/":                              {"audit:read"},
+		"/" + auditv1.AuditService_ServiceDesc.ServiceName + "/EraseSubject":                  {"audit:erase"},
+		"/" + deadletterv1.DeadLetterService_ServiceDesc.ServiceName + "/":                    {"deadletters:read"},
+		"/" + deadletterv1.DeadLetterService_ServiceDesc.ServiceName + "/ReplayEntry":         {"deadletters:write"},
+		"/" + deadletterv1.DeadLetterService_ServiceDesc.ServiceName + "/EditEntry":           {"deadletters:write"},
+		"/" + deadletterv1.DeadLetterService_ServiceDesc.ServiceName + "/DiscardEntry":        {"deadletters:write"},
+		"/" + superofficev1.SuperOfficeService_ServiceDesc.ServiceName + "/":                  {"superoffice:read"},
+		"/" + superofficev1.SuperOfficeService_ServiceDesc.ServiceName + "/CreateContact":     {"superoffice:write"},
+		"/" + superofficev1.SuperOfficeService_ServiceDesc.ServiceName + "/UpdateContact":     {"superoffice:write"},
+		"/" + superofficev1.SuperOfficeService_ServiceDesc.ServiceName + "/CreatePerson":      {"superoffice:write"},
+		"/" + superofficev1.SuperOfficeService_ServiceDesc.ServiceName + "/UpdatePerson":      {"superoffice:write"},
+		"/" + superofficev1.SuperOfficeService_ServiceDesc.ServiceName + "/CreateSale":        {"superoffice:write"},
+		"/" + superofficev1.SuperOfficeService_ServiceDesc.ServiceName + "/UpdateSale":        {"superoffice:write"},
+		"/" + superofficev1.SuperOfficeService_ServiceDesc.ServiceName + "/CreateAppointment": {"superoffice:write"},
+		"/" + vismav1.VismaService_ServiceDesc.ServiceName + "/":                              {"visma:read"},
+		"/" + vismav1.VismaService_ServiceDesc.ServiceName + "/CreateCustomer":                {"visma:write"},
+		"/" + vismav1.VismaService_ServiceDesc.ServiceName + "/UpdateCustomer":                {"visma:write"},
+		"/" + vismav1.VismaService_ServiceDesc.ServiceName + "/CreateSupplier":                {"visma:write"},
+		"/" + vismav1.VismaService_ServiceDesc.ServiceName + "/UpdateSupplier":                {"visma:write"},
+		"/" + vismav1.VismaService_ServiceDesc.ServiceName + "/CreateSalesInvoice":            {"visma:write"},
+		"/" + syncv1.SyncService_ServiceDesc.ServiceName + "/":                                {"sync:read"},
+		"/" + syncv1.SyncService_ServiceDesc.ServiceName + "/RunSync":                         {"sync:run"},
+		"/" + integrationv1.IntegrationService_ServiceDesc.ServiceName + "/":                  {"integrations:read"},
+		"/" + integrationv1.IntegrationService_ServiceDesc.ServiceName + "/TestConnection":    {"integrations:test"},
+	}
+	// Request IDs go first, then the audit interceptors so authentication can
+	// name the actor of the request event
+	grpcOpts = append(grpcOpts,
+		grpcServer.ChainUnaryInterceptor(audit.RequestIDUnaryInterceptor()),
+		grpcServer.ChainStreamInterceptor(audit.RequestIDStreamInterceptor()),
+	)
+	if cfg.Audit.Requests.Enabled {
+		grpcOpts = append(grpcOpts,
+			grpcServer.ChainUnaryInterceptor(auditLogger.UnaryInterceptor()),
+			grpcServer.ChainStreamInterceptor(auditLogger.StreamInterceptor()),
+		)
+	}
+	grpcOpts = append(grpcOpts,
+		grpcServer.ChainUnaryInterceptor(apiKeyAuth.UnaryInterceptor(grpcScopes)),
+		grpcServer.ChainStreamInterceptor(apiKeyAuth.StreamInterceptor(grpcScopes)),
+	)
+
 	// Create gRPC server with options
 	grpcSrv := grpcServer.NewServer(grpcOpts...)
 
@@ -278,6 +760,35 @@ func main() {
 		fmt.Println("✅ Database gRPC service registered")
 	}
 
+	if superOfficeClient != nil {
+		superofficev1.RegisterSuperOfficeServiceServer(grpcSrv, grpc.NewSuperOfficeServiceServer(superOfficeClient))
+		fmt.Println("✅ SuperOffice gRPC service registered")
+	}
+
+	if vismaClient != nil {
+		vismav1.RegisterVismaServiceServer(grpcSrv, grpc.NewVismaServiceServer(vismaClient))
+		fmt.Println("✅ Visma gRPC service registered")
+	}
+
+	if syncEngine != nil {
+		syncv1.RegisterSyncServiceServer(grpcSrv, grpc.NewSyncServiceServer(syncEngine))
+		fmt.Println("✅ Customer sync gRPC service registered")
+	}
+
+	if deadLetters != nil {
+		deadletterv1.RegisterDeadLetterServiceServer(grpcSrv, grpc.NewDeadLetterServiceServer(deadLetters))
+		fmt.Println("✅ Dead-letter gRPC service registered")
+	}
+
+	auditStores := len(auditLogger.Stores()) > 0
+	if auditStores {
+		auditv1.RegisterAuditServiceServer(grpcSrv, grpc.NewAuditServiceServer(auditLogger, auditPublicKey, auditLifecycle))
+		fmt.Println("✅ Audit gRPC service registered")
+	}
+
+	integrationv1.RegisterIntegrationServiceServer(grpcSrv, grpc.NewIntegrationServiceServer(superOfficeClient, vismaClient, tokenManager, breakers))
+	fmt.Println("✅ Integration gRPC service registered")
+
 	// Register reflection service (for tools like grpcurl)
 	reflection.Register(grpcSrv)
 	fmt.Println("✅ gRPC reflection registered")
@@ -290,6 +801,22 @@ func main() {
 		fmt.Println("  - aquatiq.gateway.docker.v1.DockerService")
 		fmt.Println("  - aquatiq.gateway.whitelist.v1.WhitelistService")
 		fmt.Println("  - aquatiq.gateway.database.v1.DatabaseService")
+		if superOfficeClient != nil {
+			fmt.Println("  - aquatiq.gateway.superoffice.v1.SuperOfficeService")
+		}
+		if vismaClient != nil {
+			fmt.Println("  - aquatiq.gateway.visma.v1.VismaService")
+		}
+		if syncEngine != nil {
+			fmt.Println("  - aquatiq.gateway.sync.v1.SyncService")
+		}
+		fmt.Println("  - aquatiq.gateway.integration.v1.IntegrationService")
+		if deadLetters != nil {
+			fmt.Println("  - aquatiq.gateway.deadletter.v1.DeadLetterService")
+		}
+		if auditStores {
+			fmt.Println("  - aquatiq.gateway.audit.v1.AuditService (API key, audit:read; EraseSubject audit:erase)")
+		}
 		fmt.Println("\n💡 Test with: grpcurl -plaintext localhost:50051 list")
 		fmt.Println("\nPress Ctrl+C to shutdown...")
 
@@ -318,10 +845,269 @@ func main() {
 	grpcSrv.GracefulStop()
 	fmt.Println("✅ gRPC server stopped")
 
-	auditLogger.Sync()
+	// Flush and close the audit sinks last, after the servers stopped producing events
+	if auditLifecycle != nil {
+		auditLifecycle.Stop()
+	}
+	auditLogger.Close()
 	fmt.Println("✅ Shutdown complete")
 }
 
+// addAuditSinks adds the configured file and Postgres audit sinks
+func addAuditSinks(cfg *config.Config, auditLogger *audit.AuditLogger) {
+	if cfg.Audit.File.Enabled {
+		sink, err := audit.NewFileSink(audit.FileSinkConfig{
+			Path:       cfg.Audit.File.Path,
+			MaxSize:    int64(cfg.Audit.File.MaxSizeMB) << 20,
+			MaxAge:     cfg.Audit.File.MaxAge,
+			MaxBackups: cfg.Audit.File.MaxBackups,
+		})
+		if err != nil {
+			fmt.Printf("⚠️  Audit file sink disabled: %v\n", err)
+		} else {
+			auditLogger.AddSink(sink, auditSinkOptions(cfg))
+			fmt.Printf("✅ Audit events written to %s\n", cfg.Audit.File.Path)
+		}
+	}
+
+	if cfg.Audit.Postgres.Enabled {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		sink, err := audit.NewPostgresSink(ctx, cfg.Database.PostgresURL)
+		if err != nil {
+			fmt.Printf("⚠️  Audit Postgres sink disabled: %v\n", err)
+		} else {
+			auditLogger.AddSink(sink, auditSinkOptions(cfg))
+			fmt.Println("✅ Audit events stored in Postgres (audit_events)")
+		}
+	}
+}
+
+// enableAuditChain starts hash-chaining audit events and returns the public
+// key of the checkpoint signing key, nil when checkpoints are off
+func enableAuditChain(cfg *config.Config, auditLogger *audit.AuditLogger) ed25519.PublicKey {
+	if !cfg.Audit.Chain.Enabled {
+		return nil
+	}
+
+	chainCfg, err := auditChainConfig(cfg)
+	if err != nil {
+		fmt.Printf("⚠️  Audit checkpoints disabled: %v\n", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if err := auditLogger.EnableChain(ctx, chainCfg); err != nil {
+		fmt.Printf("⚠️  Audit hash chain disabled: %v\n", err)
+		return nil
+	}
+
+	id, seq, _ := auditLogger.ChainHead()
+	if chainCfg.SigningKey == nil {
+		fmt.Printf("✅ Audit hash chain %s enabled at event %d (checkpoints off: no signing key)\n", id, seq)
+		return nil
+	}
+	publicKey := chainCfg.SigningKey.Public().(ed25519.PublicKey)
+	fmt.Printf("✅ Audit hash chain %s enabled at event %d (checkpoints every %s, key %s)\n", id, seq, cfg.Audit.Chain.CheckpointInterval, audit.KeyID(publicKey))
+	return publicKey
+}
+
+// auditLifecycleConfig returns the retention and archival the config sets up;
+// without audit.retention nothing is pruned or archived
+func auditLifecycleConfig(cfg *config.Config) audit.LifecycleConfig {
+	lifecycleCfg := audit.LifecycleConfig{Interval: cfg.Audit.Retention.Interval}
+	if cfg.Audit.Retention.Enabled {
+		lifecycleCfg.DefaultRetention = cfg.Audit.Retention.Default
+		for _, policy := range cfg.Audit.Retention.Policies {
+			lifecycleCfg.Policies = append(lifecycleCfg.Policies, audit.RetentionPolicy{Action: policy.Action, MaxAge: policy.MaxAge})
+		}
+		if cfg.Audit.Retention.Archive.Enabled {
+			lifecycleCfg.ArchiveDir = cfg.Audit.Retention.Archive.Dir
+			lifecycleCfg.ArchiveAfter = cfg.Audit.Retention.Archive.After
+		}
+	}
+	return lifecycleCfg
+}
+
+// startAuditLifecycle sets up erasure on the Postgres store and, when
+// enabled, schedules retention and archival
+func startAuditLifecycle(cfg *config.Config, auditLogger *audit.AuditLogger) *audit.Lifecycle {
+	if !cfg.Audit.Postgres.Enabled {
+		return nil
+	}
+
+	lifecycleCfg := auditLifecycleConfig(cfg)
+	lifecycle, err := audit.NewLifecycle(auditLogger, lifecycleCfg)
+	if err != nil {
+		fmt.Printf("⚠️  Audit retention and erasure disabled: %v\n", err)
+		return nil
+	}
+	if !cfg.Audit.Retention.Enabled {
+		return lifecycle
+	}
+
+	lifecycle.Start()
+	if lifecycleCfg.ArchiveDir != "" {
+		fmt.Printf("✅ Audit retention every %s, %d policies, archiving to %s\n", lifecycleCfg.Interval, len(lifecycleCfg.Policies), lifecycleCfg.ArchiveDir)
+	} else {
+		fmt.Printf("✅ Audit retention every %s, %d policies\n", lifecycleCfg.Interval, len(lifecycleCfg.Policies))
+	}
+	return lifecycle
+}
+
+// auditChainConfig returns the configured chain; an unusable signing key is
+// reported but leaves the rest of the chain configuration usable
+func auditChainConfig(cfg *config.Config) (audit.ChainConfig, error) {
+	chainCfg := audit.ChainConfig{
+		ID:                 cfg.Audit.Chain.ID,
+		CheckpointInterval: cfg.Audit.Chain.CheckpointInterval,
+	}
+	if chainCfg.ID == "" {
+		chainCfg.ID, _ = os.Hostname()
+	}
+	if cfg.Audit.Chain.SigningKey == "" {
+		return chainCfg, nil
+	}
+
+	key, err := audit.ParseSigningKey(cfg.Audit.Chain.SigningKey)
+	if err != nil {
+		return chainCfg, err
+	}
+	chainCfg.SigningKey = key
+	return chainCfg, nil
+}
+
+// auditSinkOptions returns the configured buffering for audit sinks
+func auditSinkOptions(cfg *config.Config) audit.SinkOptions {
+	return audit.SinkOptions{
+		BufferSize:    cfg.Audit.BufferSize,
+		BatchSize:     cfg.Audit.BatchSize,
+		FlushInterval: cfg.Audit.FlushInterval,
+		Backpressure:  cfg.Audit.Backpressure,
+		BlockTimeout:  cfg.Audit.BlockTimeout,
+	}
+}
+
+// newSyncEngine creates the customer sync engine and its Postgres store
+func newSyncEngine(cfg *config.Config, superOfficeClient *superoffice.Client, vismaClient *visma.Client, auditLogger *audit.AuditLogger) (*customersync.Engine, error) {
+	if superOfficeClient == nil || vismaClient == nil {
+		return nil, fmt.Errorf("superoffice and visma clients are required")
+	}
+	if cfg.Database.PostgresURL == "" {
+		return nil, fmt.Errorf("database.postgres_url is required")
+	}
+
+	company := cfg.Sync.VismaCompany
+	if company == "" {
+		company = cfg.Integrations.Visma.CompanyID
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	store, err := customersync.NewStore(ctx, cfg.Database.PostgresURL)
+	if err != nil {
+		return nil, err
+	}
+
+	engine, err := customersync.New(customersync.Config{
+		SuperOffice: superOfficeClient,
+		Visma:       vismaClient,
+		Store:       store,
+		Sync:        cfg.Sync,
+		Company:     company,
+		AuditLogger: auditLogger,
+	})
+	if err != nil {
+		store.Close()
+		return nil, err
+	}
+	return engine, nil
+}
+
+// newDeadLetterQueue creates the dead-letter queue with the configured store
+func newDeadLetterQueue(cfg *config.Config, auditLogger *audit.AuditLogger) (*deadletter.Queue, error) {
+	var store deadletter.Store
+	switch cfg.DeadLetter.Store {
+	case "memory":
+		store = deadletter.NewMemoryStore()
+	default:
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		pgStore, err := deadletter.NewPostgresStore(ctx, cfg.Database.PostgresURL)
+		if err != nil {
+			return nil, err
+		}
+		store = pgStore
+	}
+
+	return deadletter.New(deadletter.Config{
+		Store:       store,
+		Interval:    cfg.DeadLetter.Interval,
+		BatchSize:   cfg.DeadLetter.BatchSize,
+		MaxAttempts: cfg.DeadLetter.MaxAttempts,
+		BaseDelay:   cfg.DeadLetter.BaseDelay,
+		MaxDelay:    cfg.DeadLetter.MaxDelay,
+		AuditLogger: auditLogger,
+	})
+}
+
+// invalidateFromWebhook drops cached responses of the entity a webhook event changed
+func invalidateFromWebhook(event webhooks.Event, superOfficeClient *superoffice.Client, vismaClient *visma.Client, auditLogger *audit.AuditLogger) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var err error
+	switch {
+	case event.Provider == superoffice.ServiceName && superOfficeClient != nil:
+		err = superOfficeClient.InvalidateFromWebhook(ctx, event.Type, event.Payload)
+	case event.Provider == visma.ServiceName && vismaClient != nil:
+		err = vismaClient.InvalidateFromWebhook(ctx, event.Type, event.Payload)
+	}
+	if err != nil {
+		auditLogger.LogEvent(audit.AuditEvent{
+			Timestamp: time.Now(),
+			Action:    "integration_cache_invalidate",
+			Actor:     "gateway",
+			Resource:  event.Provider,
+			Success:   false,
+			Error:     err.Error(),
+			Details:   map[string]string{"event_id": event.ID, "event_type": event.Type},
+		})
+	}
+}
+
+// newWebhookHandler creates the webhook receiver with the configured queue
+func newWebhookHandler(cfg *config.Config, jetStream *messaging.JetStream, redisCache *cache.RedisCache, auditLogger *audit.AuditLogger) (*webhooks.Handler, error) {
+	var queue webhooks.Queue
+	switch cfg.Webhooks.Queue {
+	case "redis":
+		if redisCache == nil {
+			return nil, fmt.Errorf("the redis queue requires Redis")
+		}
+		queue = webhooks.NewRedisStreamQueue(redisCache, strings.ToLower(cfg.Webhooks.Stream), 100000)
+	default:
+		if jetStream == nil {
+			return nil, fmt.Errorf("the nats queue requires NATS")
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		jsQueue, err := webhooks.NewJetStreamQueue(ctx, jetStream, cfg.Webhooks.Stream, 7*24*time.Hour)
+		if err != nil {
+			return nil, err
+		}
+		queue = jsQueue
+	}
+
+	return webhooks.New(webhooks.Config{
+		Providers:    cfg.Webhooks.Providers,
+		Queue:        queue,
+		Cache:        redisCache,
+		DedupTTL:     cfg.Webhooks.DedupTTL,
+		MaxBodyBytes: cfg.Webhooks.MaxBodyBytes,
+		AuditLogger:  auditLogger,
+	})
+}
+
 // getDefaultConfig returns default configuration for testing
 func getDefaultConfig() *config.Config {
 	return &config.Config{
@@ -333,5 +1119,6 @@ func getDefaultConfig() *config.Config {
 			ShutdownTimeout: 30 * time.Second,
 			APIKey:          "test-api-key",
 		},
+		Audit: config.AuditConfig{Stdout: true},
 	}
 }
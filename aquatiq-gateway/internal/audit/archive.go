@@ -0,0 +1,147 @@
+package audit
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// archiveMonthFormat names monthly archives so they sort by age, e.g. audit-2026-01.jsonl.gz
+const archiveMonthFormat = "2006-01"
+
+// ArchiveStore reads the monthly archives in a directory as one chain store
+type ArchiveStore struct {
+	dir string
+}
+
+// NewArchiveStore returns a store over the archives in dir
+func NewArchiveStore(dir string) *ArchiveStore {
+	return &ArchiveStore{dir: dir}
+}
+
+// Files returns the archive files, oldest month first
+func (s *ArchiveStore) Files() ([]string, error) {
+	matches, err := filepath.Glob(filepath.Join(s.dir, "audit-*.jsonl.gz"))
+	if err != nil {
+		return nil, err
+	}
+
+	files := matches[:0]
+	for _, match := range matches {
+		if _, ok := archiveMonth(match); ok {
+			files = append(files, match)
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// Head implements ChainStore
+func (s *ArchiveStore) Head(ctx context.Context, chain string) (uint64, string, error) {
+	var seq uint64
+	var hash string
+	err := s.Events(ctx, func(event AuditEvent, position string) error {
+		if event.Chain == chain && event.Seq > seq {
+			seq, hash = event.Seq, event.Hash
+		}
+		return nil
+	})
+	return seq, hash, err
+}
+
+// Events implements ChainStore
+func (s *ArchiveStore) Events(ctx context.Context, fn func(event AuditEvent, position string) error) error {
+	files, err := s.Files()
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		if err := readEvents(ctx, file, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// archiveWriter writes an archive to a temporary file that replaces the
+// archive only once it is complete and synced
+type archiveWriter struct {
+	path string
+	file *os.File
+	buf  *bufio.Writer
+	gz   *gzip.Writer
+}
+
+// newArchiveWriter starts writing the archive at path
+func newArchiveWriter(path string) (*archiveWriter, error) {
+	file, err := os.OpenFile(path+".tmp", os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o640)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create audit archive: %w", err)
+	}
+	buf := bufio.NewWriterSize(file, 256<<10)
+	return &archiveWriter{path: path, file: file, buf: buf, gz: gzip.NewWriter(buf)}, nil
+}
+
+// Write appends one event
+func (w *archiveWriter) Write(event AuditEvent) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+	if _, err := w.gz.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write audit archive: %w", err)
+	}
+	return nil
+}
+
+// Commit completes the archive and moves it into place
+func (w *archiveWriter) Commit() error {
+	if err := w.gz.Close(); err != nil {
+		w.Abort()
+		return fmt.Errorf("failed to write audit archive: %w", err)
+	}
+	if err := w.buf.Flush(); err != nil {
+		w.Abort()
+		return fmt.Errorf("failed to write audit archive: %w", err)
+	}
+	if err := w.file.Sync(); err != nil {
+		w.Abort()
+		return fmt.Errorf("failed to sync audit archive: %w", err)
+	}
+	if err := w.file.Close(); err != nil {
+		os.Remove(w.file.Name())
+		return fmt.Errorf("failed to close audit archive: %w", err)
+	}
+	if err := os.Rename(w.file.Name(), w.path); err != nil {
+		os.Remove(w.file.Name())
+		return fmt.Errorf("failed to move audit archive into place: %w", err)
+	}
+	return nil
+}
+
+// Abort discards the archive being written
+func (w *archiveWriter) Abort() {
+	w.file.Close()
+	os.Remove(w.file.Name())
+}
+
+// Helper functions
+
+// archivePath returns the archive file of a month
+func archivePath(dir string, month time.Time) string {
+	return filepath.Join(dir, "audit-"+month.Format(archiveMonthFormat)+".jsonl.gz")
+}
+
+// archiveMonth returns the month an archive file holds
+func archiveMonth(path string) (time.Time, bool) {
+	name := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(path), "audit-"), ".jsonl.gz")
+	month, err := time.Parse(archiveMonthFormat, name)
+	return month, err == nil
+}
@@ -0,0 +1,355 @@
+package audit
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CheckpointAction is the action of the signed checkpoints written into the chain
+const CheckpointAction = "audit_checkpoint"
+
+// DroppedAction is the action of the markers written into the chain for events
+// a sink lost; they name the sink and the sequence ranges it is missing
+const DroppedAction = "audit_dropped"
+
+// ErasedPrefix marks an actor, IP address or user agent replaced by its
+// commitment on erasure; the chain hash covers only the commitment, so erased
+// events still verify
+const ErasedPrefix = "erased:"
+
+// personalFields are the event fields that enter the chain hash as salted
+// commitments, by the names used in Salts
+var personalFields = []string{"actor", "ip_address", "user_agent"}
+
+// ChainConfig holds how audit events are hash-chained
+type ChainConfig struct {
+	ID                 string             // Chain identifier; one per gateway instance writing to a store
+	SigningKey         ed25519.PrivateKey // Signs checkpoints; checkpoints are off without it
+	CheckpointInterval time.Duration      // Time between checkpoints (default 5m)
+}
+
+// chain links every event to the hash of the one before it
+type chain struct {
+	mu       sync.Mutex
+	id       string
+	seq      uint64
+	head     string
+	key      ed25519.PrivateKey
+	keyID    string
+	unsigned int // Events since the last checkpoint
+
+	stop    chan struct{}
+	stopped chan struct{}
+	once    sync.Once
+}
+
+// EnableChain starts hash-chaining events. The chain resumes from the
+// furthest head found in the sinks that are chain stores, so it must be
+// called after those sinks are added and before events are logged.
+func (a *AuditLogger) EnableChain(ctx context.Context, cfg ChainConfig) error {
+	if cfg.ID == "" {
+		return fmt.Errorf("audit chain ID is required")
+	}
+	if cfg.CheckpointInterval <= 0 {
+		cfg.CheckpointInterval = 5 * time.Minute
+	}
+
+	c := &chain{id: cfg.ID, key: cfg.SigningKey}
+	for _, store := range a.Stores() {
+		seq, hash, err := store.Head(ctx, cfg.ID)
+		if err != nil {
+			return fmt.Errorf("failed to read chain head: %w", err)
+		}
+		if seq > c.seq {
+			c.seq, c.head = seq, hash
+		}
+	}
+
+	if c.key != nil {
+		c.keyID = KeyID(c.key.Public().(ed25519.PublicKey))
+		c.stop = make(chan struct{})
+		c.stopped = make(chan struct{})
+		go a.checkpoints(c, cfg.CheckpointInterval)
+	}
+
+	a.mu.Lock()
+	a.chain = c
+	a.mu.Unlock()
+	return nil
+}
+
+// ChainHead returns the chain ID, sequence and hash of the last chained event
+func (a *AuditLogger) ChainHead() (string, uint64, string) {
+	a.mu.RLock()
+	c := a.chain
+	a.mu.RUnlock()
+	if c == nil {
+		return "", 0, ""
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.id, c.seq, c.head
+}
+
+// Checkpoint writes a signed checkpoint of the chain head now
+func (a *AuditLogger) Checkpoint() {
+	a.mu.RLock()
+	c := a.chain
+	a.mu.RUnlock()
+	if c != nil && c.key != nil {
+		a.emit(AuditEvent{
+			Timestamp: time.Now(),
+			Action:    CheckpointAction,
+			Actor:     "gateway",
+			Resource:  "audit",
+			Success:   true,
+		})
+	}
+}
+
+// EventHash returns the chain hash of an event from its content and
+// PrevHash; Hash itself is ignored. The timestamp counts in microseconds and
+// the duration in milliseconds, the precision the Postgres store keeps.
+func EventHash(event AuditEvent) string {
+	details := event.Details
+	if len(details) == 0 {
+		details = nil
+	}
+
+	// Personal fields enter the hash as commitments
+	data, _ := json.Marshal(struct {
+		Chain      string            `json:"chain"`
+		Seq        uint64            `json:"seq"`
+		PrevHash   string            `json:"prev_hash"`
+		Timestamp  int64             `json:"timestamp"`
+		Action     string            `json:"action"`
+		Actor      string            `json:"actor"`
+		Resource   string            `json:"resource"`
+		Success    bool              `json:"success"`
+		Error      string            `json:"error"`
+		Details    map[string]string `json:"details"`
+		IPAddress  string            `json:"ip_address"`
+		UserAgent  string            `json:"user_agent"`
+		DurationMs int64             `json:"duration_ms"`
+		RequestID  string            `json:"request_id"`
+	}{
+		Chain:      event.Chain,
+		Seq:        event.Seq,
+		PrevHash:   event.PrevHash,
+		Timestamp:  event.Timestamp.UnixMicro(),
+		Action:     event.Action,
+		Actor:      fieldDigest(event.Actor, event.Salts["actor"]),
+		Resource:   event.Resource,
+		Success:    event.Success,
+		Error:      event.Error,
+		Details:    details,
+		IPAddress:  fieldDigest(event.IPAddress, event.Salts["ip_address"]),
+		UserAgent:  fieldDigest(event.UserAgent, event.Salts["user_agent"]),
+		DurationMs: event.Duration.Milliseconds(),
+		RequestID:  event.RequestID,
+	})
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// ParseSigningKey decodes a base64 Ed25519 seed (32 bytes) or private key (64 bytes)
+func ParseSigningKey(encoded string) (ed25519.PrivateKey, error) {
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("signing key is not base64: %w", err)
+	}
+	switch len(raw) {
+	case ed25519.SeedSize:
+		return ed25519.NewKeyFromSeed(raw), nil
+	case ed25519.PrivateKeySize:
+		return ed25519.PrivateKey(raw), nil
+	default:
+		return nil, fmt.Errorf("signing key must be %d or %d bytes, got %d", ed25519.SeedSize, ed25519.PrivateKeySize, len(raw))
+	}
+}
+
+// ParsePublicKey decodes a base64 Ed25519 public key
+func ParsePublicKey(encoded string) (ed25519.PublicKey, error) {
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("public key is not base64: %w", err)
+	}
+	if len(raw) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("public key must be %d bytes, got %d", ed25519.PublicKeySize, len(raw))
+	}
+	return ed25519.PublicKey(raw), nil
+}
+
+// KeyID returns a short identifier of a checkpoint key
+func KeyID(key ed25519.PublicKey) string {
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:8])
+}
+
+// Helper functions
+
+// link assigns the event its place in the chain and signs it when it is a
+// checkpoint or dropped marker. Called with c.mu held.
+func (c *chain) link(event *AuditEvent) {
+	event.Timestamp = event.Timestamp.UTC().Truncate(time.Microsecond)
+	event.Chain = c.id
+	event.Seq = c.seq + 1
+	event.PrevHash = c.head
+	event.Salts = newSalts(*event)
+
+	if event.Action == CheckpointAction && c.key != nil {
+		// The checkpoint vouches for the event before it
+		event.Details = map[string]string{
+			"checkpoint_seq":  strconv.FormatUint(c.seq, 10),
+			"checkpoint_hash": c.head,
+			"key_id":          c.keyID,
+			"signature":       base64.StdEncoding.EncodeToString(ed25519.Sign(c.key, checkpointMessage(c.id, c.seq, c.head))),
+		}
+		c.unsigned = 0
+	} else {
+		c.unsigned++
+	}
+	if event.Action == DroppedAction && c.key != nil {
+		event.Details["key_id"] = c.keyID
+		event.Details["signature"] = base64.StdEncoding.EncodeToString(ed25519.Sign(c.key,
+			droppedMessage(c.id, event.Seq, event.Details["sink"], event.Details["missed"])))
+	}
+
+	event.Hash = EventHash(*event)
+	c.seq, c.head = event.Seq, event.Hash
+}
+
+// checkpoints writes a checkpoint every interval in which events were logged
+func (a *AuditLogger) checkpoints(c *chain, interval time.Duration) {
+	defer close(c.stopped)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			c.mu.Lock()
+			due := c.unsigned > 0
+			c.mu.Unlock()
+			if due {
+				a.Checkpoint()
+			}
+		case <-c.stop:
+			return
+		}
+	}
+}
+
+// closeChain stops checkpointing and writes a final checkpoint
+func (a *AuditLogger) closeChain() {
+	a.mu.RLock()
+	c := a.chain
+	a.mu.RUnlock()
+	if c == nil || c.key == nil {
+		return
+	}
+
+	c.once.Do(func() {
+		close(c.stop)
+		<-c.stopped
+
+		c.mu.Lock()
+		due := c.unsigned > 0
+		c.mu.Unlock()
+		if due {
+			a.Checkpoint()
+		}
+	})
+}
+
+// checkpointMessage is what a checkpoint signature covers
+func checkpointMessage(chain string, seq uint64, hash string) []byte {
+	return []byte(fmt.Sprintf("%s:%d:%s", chain, seq, hash))
+}
+
+// droppedMessage is what a dropped marker signature covers
+func droppedMessage(chain string, seq uint64, sink, missed string) []byte {
+	return []byte(fmt.Sprintf("%s:%d:dropped:%s:%s", chain, seq, sink, missed))
+}
+
+// newSalts returns a random salt for each personal field an event has
+func newSalts(event AuditEvent) map[string]string {
+	var salts map[string]string
+	for _, field := range personalFields {
+		if *personalField(&event, field) == "" {
+			continue
+		}
+		if salts == nil {
+			salts = make(map[string]string, len(personalFields))
+		}
+		salts[field] = rand.Text()
+	}
+	return salts
+}
+
+// personalField returns the named personal field of an event, or nil
+func personalField(event *AuditEvent, field string) *string {
+	switch field {
+	case "actor":
+		return &event.Actor
+	case "ip_address":
+		return &event.IPAddress
+	case "user_agent":
+		return &event.UserAgent
+	default:
+		return nil
+	}
+}
+
+// fieldDigest commits to a personal field for the chain hash with an
+// HMAC keyed by the field's salt; without the salt the commitment cannot be
+// reversed by guessing values. An erased field already is its commitment.
+// Events chained before salts were introduced have none and use a plain SHA-256.
+func fieldDigest(value, salt string) string {
+	if value == "" {
+		return ""
+	}
+	if digest, ok := strings.CutPrefix(value, ErasedPrefix); ok {
+		return digest
+	}
+	if salt == "" {
+		sum := sha256.Sum256([]byte(value))
+		return hex.EncodeToString(sum[:])
+	}
+	mac := hmac.New(sha256.New, []byte(salt))
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// eraseField replaces a personal field with its commitment and destroys the
+// salt, which leaves nothing to test guesses against. Unchained events have no
+// hash to keep, so a throwaway salt is used.
+func eraseField(event *AuditEvent, field string) {
+	value := personalField(event, field)
+	if value == nil || *value == "" || strings.HasPrefix(*value, ErasedPrefix) {
+		return
+	}
+
+	salt := event.Salts[field]
+	if salt == "" && event.Chain == "" {
+		salt = rand.Text()
+	}
+	*value = ErasedPrefix + fieldDigest(*value, salt)
+
+	delete(event.Salts, field)
+	if len(event.Salts) == 0 {
+		event.Salts = nil
+	}
+}
@@ -0,0 +1,119 @@
+package audit
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Export formats
+const (
+	FormatJSONL = "jsonl" // One event per line, as the file sink writes them plus the store ID
+	FormatCSV   = "csv"
+)
+
+// csvHeader names the CSV columns
+var csvHeader = []string{
+	"id", "timestamp", "action", "actor", "resource", "success", "error", "ip_address", "user_agent",
+	"duration_ms", "request_id", "details", "chain", "seq", "prev_hash", "hash", "pruned",
+}
+
+// ExportWriter encodes records in an export format
+type ExportWriter struct {
+	format string
+	w      io.Writer
+	csv    *csv.Writer
+	header bool
+}
+
+// NewExportWriter returns a writer encoding records to w; format defaults to jsonl
+func NewExportWriter(w io.Writer, format string) (*ExportWriter, error) {
+	switch format {
+	case "", FormatJSONL:
+		return &ExportWriter{format: FormatJSONL, w: w}, nil
+	case FormatCSV:
+		return &ExportWriter{format: FormatCSV, w: w, csv: csv.NewWriter(w)}, nil
+	default:
+		return nil, fmt.Errorf("unknown export format %q, expected jsonl or csv", format)
+	}
+}
+
+// Write encodes one record
+func (e *ExportWriter) Write(record Record) error {
+	if e.format == FormatJSONL {
+		line, err := json.Marshal(struct {
+			ID int64 `json:"id"`
+			AuditEvent
+		}{record.ID, record.Event})
+		if err != nil {
+			return fmt.Errorf("failed to encode event: %w", err)
+		}
+		_, err = e.w.Write(append(line, '\n'))
+		return err
+	}
+
+	if !e.header {
+		if err := e.csv.Write(csvHeader); err != nil {
+			return err
+		}
+		e.header = true
+	}
+	event := record.Event
+	details := ""
+	if len(event.Details) > 0 {
+		data, _ := json.Marshal(event.Details)
+		details = string(data)
+	}
+	return e.csv.Write([]string{
+		strconv.FormatInt(record.ID, 10),
+		event.Timestamp.UTC().Format(time.RFC3339Nano),
+		csvCell(event.Action),
+		csvCell(event.Actor),
+		csvCell(event.Resource),
+		strconv.FormatBool(event.Success),
+		csvCell(event.Error),
+		csvCell(event.IPAddress),
+		csvCell(event.UserAgent),
+		strconv.FormatInt(event.Duration.Milliseconds(), 10),
+		csvCell(event.RequestID),
+		csvCell(details),
+		csvCell(event.Chain),
+		strconv.FormatUint(event.Seq, 10),
+		event.PrevHash,
+		event.Hash,
+		strconv.FormatBool(event.Pruned),
+	})
+}
+
+// Flush writes buffered CSV rows; a CSV export has its header even without rows
+func (e *ExportWriter) Flush() error {
+	if e.csv == nil {
+		return nil
+	}
+	if !e.header {
+		if err := e.csv.Write(csvHeader); err != nil {
+			return err
+		}
+		e.header = true
+	}
+	e.csv.Flush()
+	return e.csv.Error()
+}
+
+// csvCell escapes a text value a spreadsheet would run as a formula: one
+// starting with a tab or carriage return, or with =, +, - or @ after any
+// leading whitespace
+func csvCell(value string) string {
+	if strings.HasPrefix(value, "\t") || strings.HasPrefix(value, "\r") {
+		return "'" + value
+	}
+	trimmed := strings.TrimLeft(value, " \t\r\n")
+	if trimmed != "" && strings.ContainsRune("=+-@", rune(trimmed[0])) {
+		return "'" + value
+	}
+	return value
+}
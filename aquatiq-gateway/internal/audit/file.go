@@ -0,0 +1,350 @@
+package audit
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// rotatedTimeFormat names rotated files so they sort by age
+const rotatedTimeFormat = "20060102T150405.000"
+
+// FileSinkConfig holds where the audit file is written and when it is rotated
+type FileSinkConfig struct {
+	Path       string        // e.g. /var/log/aquatiq/audit.jsonl
+	MaxSize    int64         // Bytes before the file is rotated; 0 disables size rotation
+	MaxAge     time.Duration // Rotation period, e.g. 24h rotates daily; 0 disables time rotation
+	MaxBackups int           // Rotated files kept; 0 keeps all
+}
+
+// FileSink writes events as JSON lines to a file that is rotated by size
+// and time. Rotated files are renamed to {name}-{time}{ext} next to it.
+type FileSink struct {
+	cfg FileSinkConfig
+
+	mu      sync.Mutex
+	file    *os.File
+	w       *bufio.Writer
+	size    int64
+	started time.Time // Start of the period the current file belongs to
+}
+
+// NewFileSink opens or creates the audit file
+func NewFileSink(cfg FileSinkConfig) (*FileSink, error) {
+	if cfg.Path == "" {
+		return nil, fmt.Errorf("audit file path is required")
+	}
+	if err := os.MkdirAll(filepath.Dir(cfg.Path), 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create audit directory: %w", err)
+	}
+
+	s := &FileSink{cfg: cfg}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Name implements Sink
+func (s *FileSink) Name() string {
+	return "file"
+}
+
+// Write implements Sink
+func (s *FileSink) Write(ctx context.Context, events []AuditEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		return fmt.Errorf("audit file is closed")
+	}
+	for _, event := range events {
+		line, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("failed to encode event: %w", err)
+		}
+		line = append(line, '\n')
+
+		if s.due(time.Now(), int64(len(line))) {
+			if err := s.rotate(); err != nil {
+				return err
+			}
+		}
+		n, err := s.w.Write(line)
+		s.size += int64(n)
+		if err != nil {
+			return fmt.Errorf("failed to write audit file: %w", err)
+		}
+	}
+	if err := s.w.Flush(); err != nil {
+		return fmt.Errorf("failed to write audit file: %w", err)
+	}
+	return nil
+}
+
+// Sync implements Sink
+func (s *FileSink) Sync() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		return nil
+	}
+	if err := s.w.Flush(); err != nil {
+		return err
+	}
+	return s.file.Sync()
+}
+
+// Close implements Sink
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		return nil
+	}
+	err := s.w.Flush()
+	if syncErr := s.file.Sync(); err == nil {
+		err = syncErr
+	}
+	if closeErr := s.file.Close(); err == nil {
+		err = closeErr
+	}
+	s.file = nil
+	return err
+}
+
+// Files returns the rotated files, oldest first, followed by the current file
+func (s *FileSink) Files() ([]string, error) {
+	return NewFileStore(s.cfg.Path).Files()
+}
+
+// Head implements ChainStore
+func (s *FileSink) Head(ctx context.Context, chain string) (uint64, string, error) {
+	return NewFileStore(s.cfg.Path).Head(ctx, chain)
+}
+
+// Events implements ChainStore
+func (s *FileSink) Events(ctx context.Context, fn func(event AuditEvent, position string) error) error {
+	return NewFileStore(s.cfg.Path).Events(ctx, fn)
+}
+
+// FileStore reads the events of an audit file and its rotated files, e.g.
+// to verify them while no gateway is writing
+type FileStore struct {
+	path string
+}
+
+// NewFileStore returns a store reading the audit file at path
+func NewFileStore(path string) *FileStore {
+	return &FileStore{path: path}
+}
+
+// Files returns the rotated files, oldest first, followed by the current file
+func (s *FileStore) Files() ([]string, error) {
+	ext := filepath.Ext(s.path)
+	stem := strings.TrimSuffix(s.path, ext)
+	matches, err := filepath.Glob(stem + "-*" + ext)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only names with a rotation time; other files may share the prefix
+	files := matches[:0]
+	for _, match := range matches {
+		stamp := strings.TrimSuffix(strings.TrimPrefix(match, stem+"-"), ext)
+		if _, err := time.Parse(rotatedTimeFormat, stamp); err == nil {
+			files = append(files, match)
+		}
+	}
+	sort.Strings(files)
+	return append(files, s.path), nil
+}
+
+// Head implements ChainStore. Files are searched newest first.
+func (s *FileStore) Head(ctx context.Context, chain string) (uint64, string, error) {
+	files, err := s.Files()
+	if err != nil {
+		return 0, "", err
+	}
+	for i := len(files) - 1; i >= 0; i-- {
+		var seq uint64
+		var hash string
+		err := readEvents(ctx, files[i], func(event AuditEvent, position string) error {
+			if event.Chain == chain && event.Seq > seq {
+				seq, hash = event.Seq, event.Hash
+			}
+			return nil
+		})
+		if err != nil {
+			return 0, "", err
+		}
+		if seq > 0 {
+			return seq, hash, nil
+		}
+	}
+	return 0, "", nil
+}
+
+// Events implements ChainStore
+func (s *FileStore) Events(ctx context.Context, fn func(event AuditEvent, position string) error) error {
+	files, err := s.Files()
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		if err := readEvents(ctx, file, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Helper functions
+
+// open opens the current file for appending
+func (s *FileSink) open() error {
+	file, err := os.OpenFile(s.cfg.Path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o640)
+	if err != nil {
+		return fmt.Errorf("failed to open audit file: %w", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("failed to stat audit file: %w", err)
+	}
+
+	s.file = file
+	s.w = bufio.NewWriter(file)
+	s.size = info.Size()
+
+	// A file left from an earlier period is rotated on the next write
+	s.started = time.Now()
+	if s.size > 0 {
+		s.started = info.ModTime()
+	}
+	return nil
+}
+
+// due reports whether the file must be rotated before n more bytes are written
+func (s *FileSink) due(now time.Time, n int64) bool {
+	if s.size == 0 {
+		return false
+	}
+	if s.cfg.MaxSize > 0 && s.size+n > s.cfg.MaxSize {
+		return true
+	}
+	return s.cfg.MaxAge > 0 && !now.Truncate(s.cfg.MaxAge).Equal(s.started.Truncate(s.cfg.MaxAge))
+}
+
+// rotate renames the current file, opens a new one and prunes old backups
+func (s *FileSink) rotate() error {
+	if err := s.w.Flush(); err != nil {
+		return fmt.Errorf("failed to write audit file: %w", err)
+	}
+	if err := s.file.Sync(); err != nil {
+		return fmt.Errorf("failed to sync audit file: %w", err)
+	}
+	if err := s.file.Close(); err != nil {
+		return fmt.Errorf("failed to close audit file: %w", err)
+	}
+	s.file = nil
+
+	ext := filepath.Ext(s.cfg.Path)
+	stem := strings.TrimSuffix(s.cfg.Path, ext)
+	// Names must not collide, or a backup would be overwritten
+	stamp := time.Now().UTC()
+	rotated := stem + "-" + stamp.Format(rotatedTimeFormat) + ext
+	for {
+		if _, err := os.Stat(rotated); os.IsNotExist(err) {
+			break
+		}
+		stamp = stamp.Add(time.Millisecond)
+		rotated = stem + "-" + stamp.Format(rotatedTimeFormat) + ext
+	}
+	if err := os.Rename(s.cfg.Path, rotated); err != nil {
+		return fmt.Errorf("failed to rotate audit file: %w", err)
+	}
+	if err := s.open(); err != nil {
+		return err
+	}
+	s.started = time.Now()
+
+	if s.cfg.MaxBackups > 0 {
+		files, err := s.Files()
+		if err != nil {
+			return err
+		}
+		backups := files[:len(files)-1]
+		for len(backups) > s.cfg.MaxBackups {
+			if err := os.Remove(backups[0]); err != nil && !os.IsNotExist(err) {
+				return fmt.Errorf("failed to remove old audit file: %w", err)
+			}
+			backups = backups[1:]
+		}
+	}
+	return nil
+}
+
+// readEvents calls fn with every event of a JSON lines file, gzip compressed
+// when its name ends in .gz. A missing file
+// has no events, and a last line without newline is still being written.
+func readEvents(ctx context.Context, path string, fn func(event AuditEvent, position string) error) error {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open audit file: %w", err)
+	}
+	defer file.Close()
+
+	// Archives are gzip compressed
+	var src io.Reader = file
+	if strings.HasSuffix(path, ".gz") {
+		gz, err := gzip.NewReader(file)
+		if err != nil {
+			return fmt.Errorf("failed to open audit archive: %w", err)
+		}
+		defer gz.Close()
+		src = gz
+	}
+
+	r := bufio.NewReader(src)
+	name := filepath.Base(path)
+	for lineNo := 1; ; lineNo++ {
+		line, err := r.ReadBytes('\n')
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", name, err)
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if len(strings.TrimSpace(string(line))) == 0 {
+			continue
+		}
+
+		position := name + ":" + strconv.Itoa(lineNo)
+		var event AuditEvent
+		if err := json.Unmarshal(line, &event); err != nil {
+			return fmt.Errorf("invalid event at %s: %w", position, err)
+		}
+		if err := fn(event, position); err != nil {
+			return err
+		}
+	}
+}
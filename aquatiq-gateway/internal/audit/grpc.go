@@ -0,0 +1,138 @@
+package audit
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// requestIDMetadata is the gRPC metadata key carrying the request ID both ways
+const requestIDMetadata = "x-request-id"
+
+// RequestIDUnaryInterceptor takes the caller's x-request-id metadata or
+// generates one, makes it available to RequestIDFromContext and sends it back
+// as header metadata. It goes first in the interceptor chain, whether or not
+// calls are audited.
+func RequestIDUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(withRequestID(ctx), req)
+	}
+}
+
+// RequestIDStreamInterceptor is RequestIDUnaryInterceptor for streaming methods
+func RequestIDStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &requestStream{ServerStream: ss, ctx: withRequestID(ss.Context())})
+	}
+}
+
+// UnaryInterceptor logs one request event per call with the actor, method,
+// status code, duration and request ID. It goes after the request ID
+// interceptor and before authentication, so authentication can set the actor;
+// calls that arrive without a request ID get one here.
+func (a *AuditLogger) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		ctx = a.startRPC(ctx)
+		resp, err := handler(ctx, req)
+		a.logRPC(ctx, info.FullMethod, err, start)
+		return resp, err
+	}
+}
+
+// StreamInterceptor is UnaryInterceptor for streaming methods
+func (a *AuditLogger) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		ctx := a.startRPC(ss.Context())
+		err := handler(srv, &requestStream{ServerStream: ss, ctx: ctx})
+		a.logRPC(ctx, info.FullMethod, err, start)
+		return err
+	}
+}
+
+// requestStream carries the request ID and record in its context
+type requestStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the context with the request ID and record
+func (s *requestStream) Context() context.Context {
+	return s.ctx
+}
+
+// Helper functions
+
+// startRPC adds the request ID and a request record to a call's context
+func (a *AuditLogger) startRPC(ctx context.Context) context.Context {
+	return context.WithValue(withRequestID(ctx), requestKey, &requestRecord{})
+}
+
+// withRequestID adds the call's request ID to its context unless an earlier
+// interceptor already did
+func withRequestID(ctx context.Context) context.Context {
+	if RequestIDFromContext(ctx) != "" {
+		return ctx
+	}
+
+	requestID := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(requestIDMetadata); len(values) > 0 {
+			requestID = values[0]
+		}
+	}
+	if requestID == "" {
+		requestID = newRequestID()
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDMetadata, requestID))
+	return context.WithValue(ctx, middleware.RequestIDKey, requestID)
+}
+
+// logRPC logs the request event of a finished call
+func (a *AuditLogger) logRPC(ctx context.Context, method string, err error, start time.Time) {
+	code := status.Code(err)
+	event := AuditEvent{
+		Timestamp: start,
+		Action:    RequestAction,
+		Actor:     ActorFromContext(ctx),
+		Resource:  method,
+		Success:   err == nil,
+		Details: map[string]string{
+			"protocol": "grpc",
+			"method":   method,
+			"status":   code.String(),
+		},
+		Duration:  time.Since(start),
+		RequestID: RequestIDFromContext(ctx),
+	}
+	if event.Actor == "" {
+		event.Actor = "anonymous"
+	}
+	if err != nil {
+		event.Error = status.Convert(err).Message()
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		event.IPAddress = p.Addr.String()
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("user-agent"); len(values) > 0 {
+			event.UserAgent = values[0]
+		}
+	}
+	a.LogEvent(event)
+}
+
+// newRequestID generates a request ID for calls that arrive without one
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,131 @@
+package audit
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+// IP masking modes
+const (
+	IPMaskTruncate = "truncate" // Keep the network prefix, e.g. 192.0.2.0/24
+	IPMaskHMAC     = "hmac"     // Keyed pseudonym of the full address, e.g. ip-3f9a0c2e71d4b865
+)
+
+// IPMaskConfig selects how client addresses are masked before events are stored
+type IPMaskConfig struct {
+	Mode       string // truncate (default) or hmac
+	IPv4Prefix int    // Bits kept of IPv4 addresses in truncate mode (default 24)
+	IPv6Prefix int    // Bits kept of IPv6 addresses in truncate mode (default 48)
+	HMACKey    []byte // Pseudonym key in hmac mode; the same key maps a client to the same pseudonym
+}
+
+// ipMasker masks client addresses as configured
+type ipMasker struct {
+	mode       string
+	ipv4Prefix int
+	ipv6Prefix int
+	key        []byte
+}
+
+// defaultIPMasker truncates to /24 and /48 until SetIPMasking is called
+var defaultIPMasker = &ipMasker{mode: IPMaskTruncate, ipv4Prefix: 24, ipv6Prefix: 48}
+
+// newIPMasker validates cfg and applies its defaults
+func newIPMasker(cfg IPMaskConfig) (*ipMasker, error) {
+	m := &ipMasker{
+		mode:       cfg.Mode,
+		ipv4Prefix: cfg.IPv4Prefix,
+		ipv6Prefix: cfg.IPv6Prefix,
+		key:        cfg.HMACKey,
+	}
+	if m.mode == "" {
+		m.mode = IPMaskTruncate
+	}
+	if m.ipv4Prefix == 0 {
+		m.ipv4Prefix = defaultIPMasker.ipv4Prefix
+	}
+	if m.ipv6Prefix == 0 {
+		m.ipv6Prefix = defaultIPMasker.ipv6Prefix
+	}
+
+	switch m.mode {
+	case IPMaskTruncate:
+		if m.ipv4Prefix < 1 || m.ipv4Prefix > 32 {
+			return nil, fmt.Errorf("IPv4 prefix must be between 1 and 32 bits")
+		}
+		if m.ipv6Prefix < 1 || m.ipv6Prefix > 128 {
+			return nil, fmt.Errorf("IPv6 prefix must be between 1 and 128 bits")
+		}
+	case IPMaskHMAC:
+		if len(m.key) < 16 {
+			return nil, fmt.Errorf("hmac IP masking requires a key of at least 16 bytes")
+		}
+	default:
+		return nil, fmt.Errorf("unknown IP masking mode %q, expected truncate or hmac", m.mode)
+	}
+	return m, nil
+}
+
+// SetIPMasking sets how client addresses are masked in events logged from now on
+func (a *AuditLogger) SetIPMasking(cfg IPMaskConfig) error {
+	masker, err := newIPMasker(cfg)
+	if err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.ipMasker = masker
+	return nil
+}
+
+// mask returns the masked client address of value, which may be an
+// X-Forwarded-For list, a host:port pair or a bare address
+func (m *ipMasker) mask(value string) string {
+	if strings.TrimSpace(value) == "" {
+		return "unknown"
+	}
+	addr, ok := parseClientIP(value)
+	if !ok {
+		return "invalid"
+	}
+
+	if m.mode == IPMaskHMAC {
+		mac := hmac.New(sha256.New, m.key)
+		mac.Write([]byte(addr.String()))
+		return "ip-" + hex.EncodeToString(mac.Sum(nil)[:8])
+	}
+
+	bits := m.ipv4Prefix
+	if addr.Is6() {
+		bits = m.ipv6Prefix
+	}
+	prefix, err := addr.Prefix(bits)
+	if err != nil {
+		return "invalid"
+	}
+	return prefix.String()
+}
+
+// Helper functions
+
+// parseClientIP parses the client address: the first entry of a forwarded
+// list, without port or zone, and IPv4-mapped IPv6 as IPv4
+func parseClientIP(value string) (netip.Addr, bool) {
+	first, _, _ := strings.Cut(value, ",")
+	first = strings.TrimSpace(first)
+
+	var addr netip.Addr
+	if addrPort, err := netip.ParseAddrPort(first); err == nil {
+		addr = addrPort.Addr()
+	} else if parsed, err := netip.ParseAddr(strings.TrimSuffix(strings.TrimPrefix(first, "["), "]")); err == nil {
+		addr = parsed
+	} else {
+		return netip.Addr{}, false
+	}
+	return addr.Unmap().WithZone(""), true
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
@@ -13,6 +15,13 @@ import (
 // AuditLogger provides structured, PII-safe audit logging
 type AuditLogger struct {
 	logger *zap.Logger
+	stdout bool
+
+	mu       sync.RWMutex
+	order    sync.Mutex // Held while an event is linked and takes its turn at the sinks
+	sinks    []*bufferedSink
+	chain    *chain
+	ipMasker *ipMasker
 }
 
 // AuditEvent represents an audit log event
@@ -24,10 +33,23 @@ type AuditEvent struct {
 	Success   bool              `json:"success"`
 	Error     string            `json:"error,omitempty"`
 	Details   map[string]string `json:"details,omitempty"`
-	IPAddress string            `json:"ip_address"` // Masked for privacy
+	IPAddress string            `json:"ip_address"` // Masked for privacy: network prefix or keyed pseudonym
 	UserAgent string            `json:"user_agent"`
 	Duration  time.Duration     `json:"duration_ms"`
 	RequestID string            `json:"request_id,omitempty"`
+
+	// Hash chain, set when chaining is enabled
+	Chain    string `json:"chain,omitempty"`
+	Seq      uint64 `json:"seq,omitempty"`
+	PrevHash string `json:"prev_hash,omitempty"`
+	Hash     string `json:"hash,omitempty"`
+
+	// Salts key the commitments personal fields enter the chain hash as, by
+	// field name; erasure deletes a field's salt
+	Salts map[string]string `json:"salts,omitempty"`
+
+	// Pruned events had their content removed by retention; only the chain link is kept
+	Pruned bool `json:"pruned,omitempty"`
 }
 
 // NewAuditLogger creates a new audit logger
@@ -60,13 +82,68 @@ func NewAuditLogger(level string) (*AuditLogger, error) {
 		return nil, fmt.Errorf("failed to build logger: %w", err)
 	}
 
-	return &AuditLogger{logger: logger}, nil
+	return &AuditLogger{logger: logger, stdout: true}, nil
+}
+
+// AddSink starts writing events to a sink in addition to the log; events are
+// buffered in front of it as set by opts
+func (a *AuditLogger) AddSink(sink Sink, opts SinkOptions) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.sinks = append(a.sinks, newBufferedSink(sink, opts, a.logger))
+}
+
+// SetStdout sets whether events are also written to the application log
+func (a *AuditLogger) SetStdout(enabled bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.stdout = enabled
+}
+
+// Stores returns the sinks whose events can be read back, by sink name
+func (a *AuditLogger) Stores() map[string]ChainStore {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	stores := make(map[string]ChainStore)
+	for _, sink := range a.sinks {
+		if store, ok := sink.sink.(ChainStore); ok {
+			stores[sink.sink.Name()] = store
+		}
+	}
+	return stores
+}
+
+// SinkStats returns the buffer state of every sink
+func (a *AuditLogger) SinkStats() []SinkStats {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	stats := make([]SinkStats, 0, len(a.sinks))
+	for _, sink := range a.sinks {
+		stats = append(stats, sink.stats())
+	}
+	return stats
 }
 
 // LogEvent logs an audit event
 func (a *AuditLogger) LogEvent(event AuditEvent) {
-	// Mask PII in IP address (keep first 2 octets)
-	event.IPAddress = maskIP(event.IPAddress)
+	// Mask PII in IP address
+	event.IPAddress = a.maskIP(event.IPAddress)
+
+	a.emit(event)
+}
+
+// emit chains an event and hands it to the sinks and the log. Events a sink
+// lost are then recorded in the chain with a dropped marker.
+func (a *AuditLogger) emit(event AuditEvent) {
+	sinks, stdout := a.deliver(&event)
+	for _, sink := range sinks {
+		a.markDropped(sink, false)
+	}
+	if !stdout {
+		return
+	}
 
 	// Convert to JSON for structured logging
 	eventJSON, _ := json.Marshal(event)
@@ -91,12 +168,67 @@ func (a *AuditLogger) LogEvent(event AuditEvent) {
 	}
 }
 
+// deliver links an event and hands it to every sink, and returns the sinks
+// and whether events also go to the log. Linking and taking turns at the
+// sinks happen under the order lock, so sinks receive events in chain order;
+// waiting for room in a full buffer happens after it, so a slow sink does not
+// hold up the chain or the other sinks.
+func (a *AuditLogger) deliver(event *AuditEvent) ([]*bufferedSink, bool) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	since := time.Now()
+	turns := make([]turn, len(a.sinks))
+	a.order.Lock()
+	if a.chain != nil {
+		a.chain.mu.Lock()
+		a.chain.link(event)
+		a.chain.mu.Unlock()
+	}
+	for i, sink := range a.sinks {
+		turns[i] = sink.nextTurn()
+	}
+	a.order.Unlock()
+
+	for i, sink := range a.sinks {
+		sink.enqueue(*event, turns[i], since)
+	}
+	return a.sinks, a.stdout
+}
+
+// markDropped writes a dropped marker accounting for the chained events a
+// sink lost, so the gap they leave in its store still verifies; force skips
+// the wait between markers
+func (a *AuditLogger) markDropped(sink *bufferedSink, force bool) {
+	missed := sink.takeMissed(force)
+	if missed == nil {
+		return
+	}
+
+	var lost uint64
+	for _, r := range missed {
+		lost += r.Last - r.First + 1
+	}
+	a.emit(AuditEvent{
+		Timestamp: time.Now(),
+		Action:    DroppedAction,
+		Actor:     "gateway",
+		Resource:  "audit",
+		Success:   false,
+		Error:     fmt.Sprintf("%s sink lost %d events", sink.sink.Name(), lost),
+		Details: map[string]string{
+			"sink":   sink.sink.Name(),
+			"missed": formatSeqRanges(missed),
+		},
+	})
+}
+
 // LogHTTPRequest logs an HTTP request with audit trail
 func (a *AuditLogger) LogHTTPRequest(r *http.Request, action string, success bool, err error, duration time.Duration) {
 	event := AuditEvent{
 		Timestamp: time.Now(),
 		Action:    action,
-		Actor:     getActorFromRequest(r),
+		Actor:     a.getActorFromRequest(r),
 		Resource:  r.URL.Path,
 		Success:   success,
 		IPAddress: getIPAddress(r),
@@ -169,11 +301,12 @@ func (a *AuditLogger) LogRateLimitExceeded(r *http.Request, limit string) {
 	event := AuditEvent{
 		Timestamp: time.Now(),
 		Action:    "rate_limit_exceeded",
-		Actor:     getActorFromRequest(r),
+		Actor:     a.getActorFromRequest(r),
 		Resource:  r.URL.Path,
 		Success:   false,
 		IPAddress: getIPAddress(r),
 		UserAgent: r.UserAgent(),
+		RequestID: getRequestID(r),
 		Details: map[string]string{
 			"limit": limit,
 		},
@@ -192,14 +325,45 @@ func (a *AuditLogger) LogAuthFailure(r *http.Request, reason string) {
 		Success:   false,
 		IPAddress: getIPAddress(r),
 		UserAgent: r.UserAgent(),
+		RequestID: getRequestID(r),
 		Error:     reason,
 	}
 
 	a.LogEvent(event)
 }
 
-// Sync flushes any buffered log entries
+// Sync flushes any buffered log entries and waits until every sink has
+// written and synced the events queued for it
 func (a *AuditLogger) Sync() {
+	a.mu.RLock()
+	sinks := a.sinks
+	a.mu.RUnlock()
+
+	for _, sink := range sinks {
+		sink.flush()
+	}
+	_ = a.logger.Sync()
+}
+
+// Close records events the sinks lost and writes a final checkpoint, then
+// flushes and closes every sink; later events only reach the log
+func (a *AuditLogger) Close() {
+	a.mu.RLock()
+	sinks := a.sinks
+	a.mu.RUnlock()
+	for _, sink := range sinks {
+		a.markDropped(sink, true)
+	}
+	a.closeChain()
+
+	a.mu.Lock()
+	sinks = a.sinks
+	a.sinks = nil
+	a.mu.Unlock()
+
+	for _, sink := range sinks {
+		sink.close()
+	}
 	_ = a.logger.Sync()
 }
 
@@ -211,24 +375,23 @@ func (a *AuditLogger) GetLogger() *zap.Logger {
 
 // Helper functions
 
-// maskIP masks IP address for privacy (keeps first 2 octets for IPv4)
-func maskIP(ip string) string {
-	if ip == "" {
-		return "unknown"
-	}
-
-	// Simple masking - in production use more sophisticated approach
-	if len(ip) > 7 {
-		return ip[:7] + ".xxx.xxx"
+// maskIP masks IP address for privacy as set by SetIPMasking
+func (a *AuditLogger) maskIP(ip string) string {
+	a.mu.RLock()
+	masker := a.ipMasker
+	a.mu.RUnlock()
+	if masker == nil {
+		masker = defaultIPMasker
 	}
-	return "masked"
+	return masker.mask(ip)
 }
 
 // getIPAddress extracts the real IP address from request
 func getIPAddress(r *http.Request) string {
-	// Check X-Forwarded-For header first (Traefik sets this)
+	// Check X-Forwarded-For header first (Traefik sets this); the client is the first entry
 	if xff := r.Header.Get("X-Forwarded-For"); xff != "" {
-		return xff
+		first, _, _ := strings.Cut(xff, ",")
+		return strings.TrimSpace(first)
 	}
 
 	// Check X-Real-IP header
@@ -241,25 +404,21 @@ func getIPAddress(r *http.Request) string {
 }
 
 // getActorFromRequest extracts actor identifier from request
-func getActorFromRequest(r *http.Request) string {
+func (a *AuditLogger) getActorFromRequest(r *http.Request) string {
 	// Check for API key or user identifier in context
-	if ctx := r.Context(); ctx != nil {
-		if actor, ok := ctx.Value("actor").(string); ok {
-			return actor
-		}
+	if actor := ActorFromContext(r.Context()); actor != "" {
+		return actor
 	}
 
 	// Default to IP-based identifier (masked)
-	return maskIP(getIPAddress(r))
+	return a.maskIP(getIPAddress(r))
 }
 
 // getRequestID extracts request ID from context or headers
 func getRequestID(r *http.Request) string {
-	// Check context first
-	if ctx := r.Context(); ctx != nil {
-		if reqID, ok := ctx.Value("request_id").(string); ok {
-			return reqID
-		}
+	// Check context first (chi's RequestID middleware)
+	if reqID := RequestIDFromContext(r.Context()); reqID != "" {
+		return reqID
 	}
 
 	// Check X-Request-ID header (Traefik sets this)
@@ -272,12 +431,12 @@ func getRequestID(r *http.Request) string {
 
 // WithContext adds audit logger to context
 func WithContext(ctx context.Context, logger *AuditLogger) context.Context {
-	return context.WithValue(ctx, "audit_logger", logger)
+	return context.WithValue(ctx, loggerKey, logger)
 }
 
 // FromContext retrieves audit logger from context
 func FromContext(ctx context.Context) *AuditLogger {
-	if logger, ok := ctx.Value("audit_logger").(*AuditLogger); ok {
+	if logger, ok := ctx.Value(loggerKey).(*AuditLogger); ok {
 		return logger
 	}
 	return nil
@@ -0,0 +1,135 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+// RequestAction is the action of the event logged once per HTTP or gRPC request
+const RequestAction = "request"
+
+// contextKey is the type of the audit context keys
+type contextKey int
+
+const (
+	loggerKey  contextKey = iota // *AuditLogger
+	actorKey                     // string
+	requestKey                   // *requestRecord
+)
+
+// requestRecord collects what handlers learn about a request for its request
+// event; the actor is only known once authentication ran further down the chain
+type requestRecord struct {
+	mu    sync.Mutex
+	actor string
+}
+
+// WithActor returns a context naming who makes the request. The request event
+// of the enclosing Middleware or interceptor picks the actor up as well.
+func WithActor(ctx context.Context, actor string) context.Context {
+	if record, ok := ctx.Value(requestKey).(*requestRecord); ok {
+		record.mu.Lock()
+		record.actor = actor
+		record.mu.Unlock()
+	}
+	return context.WithValue(ctx, actorKey, actor)
+}
+
+// ActorFromContext returns the actor set by WithActor, or ""
+func ActorFromContext(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorKey).(string); ok {
+		return actor
+	}
+	if record, ok := ctx.Value(requestKey).(*requestRecord); ok {
+		record.mu.Lock()
+		defer record.mu.Unlock()
+		return record.actor
+	}
+	return ""
+}
+
+// RequestIDFromContext returns the request ID set by chi's RequestID
+// middleware or the gRPC interceptors, or ""
+func RequestIDFromContext(ctx context.Context) string {
+	return middleware.GetReqID(ctx)
+}
+
+// RequestIDMiddleware echoes the request ID back in the X-Request-Id header.
+// It goes right after chi's RequestID middleware, whether or not requests are
+// audited.
+func RequestIDMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requestID := RequestIDFromContext(r.Context()); requestID != "" {
+			w.Header().Set(middleware.RequestIDHeader, requestID)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Middleware logs one request event per HTTP request with the actor, route,
+// status, duration and request ID. It goes after chi's RequestID middleware
+// and before Recoverer, so panics are logged as 500s. Paths under skipPaths
+// are not logged.
+func (a *AuditLogger) Middleware(skipPaths ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestID := RequestIDFromContext(r.Context())
+			for _, prefix := range skipPaths {
+				if strings.HasPrefix(r.URL.Path, prefix) {
+					next.ServeHTTP(w, r)
+					return
+				}
+			}
+
+			start := time.Now()
+			record := &requestRecord{}
+			ctx := context.WithValue(r.Context(), requestKey, record)
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+
+			defer func() {
+				status := ww.Status()
+				if status == 0 {
+					status = http.StatusOK
+				}
+				route := r.URL.Path
+				if rctx := chi.RouteContext(ctx); rctx != nil && rctx.RoutePattern() != "" {
+					route = rctx.RoutePattern()
+				}
+
+				event := AuditEvent{
+					Timestamp: start,
+					Action:    RequestAction,
+					Actor:     ActorFromContext(ctx),
+					Resource:  r.Method + " " + route,
+					Success:   status < http.StatusBadRequest,
+					Details: map[string]string{
+						"protocol": "http",
+						"method":   r.Method,
+						"route":    route,
+						"status":   fmt.Sprint(status),
+					},
+					IPAddress: getIPAddress(r),
+					UserAgent: r.UserAgent(),
+					Duration:  time.Since(start),
+					RequestID: requestID,
+				}
+				if event.Actor == "" {
+					event.Actor = "anonymous"
+				}
+				if !event.Success {
+					event.Error = http.StatusText(status)
+				}
+				a.LogEvent(event)
+			}()
+
+			next.ServeHTTP(ww, r.WithContext(ctx))
+		})
+	}
+}
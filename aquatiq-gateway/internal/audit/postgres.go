@@ -0,0 +1,198 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	_ "github.com/lib/pq"
+)
+
+// schema creates the audit table if it does not exist
+const schema = `
+CREATE TABLE IF NOT EXISTS audit_events (
+	id          BIGSERIAL PRIMARY KEY,
+	occurred_at TIMESTAMPTZ NOT NULL,
+	action      TEXT        NOT NULL,
+	actor       TEXT        NOT NULL,
+	resource    TEXT        NOT NULL,
+	success     BOOLEAN     NOT NULL,
+	error       TEXT        NOT NULL,
+	details     JSONB       NOT NULL,
+	ip_address  TEXT        NOT NULL,
+	user_agent  TEXT        NOT NULL,
+	duration_ms BIGINT      NOT NULL,
+	request_id  TEXT        NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS audit_events_occurred_at ON audit_events (occurred_at);
+CREATE INDEX IF NOT EXISTS audit_events_actor ON audit_events (actor, occurred_at);
+CREATE INDEX IF NOT EXISTS audit_events_action ON audit_events (action, occurred_at);
+
+ALTER TABLE audit_events ADD COLUMN IF NOT EXISTS chain     TEXT   NOT NULL DEFAULT '';
+ALTER TABLE audit_events ADD COLUMN IF NOT EXISTS seq       BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE audit_events ADD COLUMN IF NOT EXISTS prev_hash TEXT   NOT NULL DEFAULT '';
+ALTER TABLE audit_events ADD COLUMN IF NOT EXISTS hash      TEXT   NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS audit_events_chain ON audit_events (chain, seq);
+
+ALTER TABLE audit_events ADD COLUMN IF NOT EXISTS pruned BOOLEAN NOT NULL DEFAULT false;
+
+ALTER TABLE audit_events ADD COLUMN IF NOT EXISTS salts JSONB NOT NULL DEFAULT '{}';
+`
+
+// columns are the event columns, in scan order
+const columns = `id, occurred_at, action, actor, resource, success, error, details, ip_address, user_agent, duration_ms, request_id, chain, seq, prev_hash, hash, pruned, salts`
+
+// PostgresSink stores events in the audit_events table
+type PostgresSink struct {
+	db *sql.DB
+}
+
+// NewPostgresSink connects to Postgres and creates the audit table
+func NewPostgresSink(ctx context.Context, postgresURL string) (*PostgresSink, error) {
+	db, err := sql.Open("postgres", postgresURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open connection: %w", err)
+	}
+	db.SetMaxOpenConns(3)
+	db.SetConnMaxLifetime(30 * time.Minute)
+
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("ping failed: %w", err)
+	}
+	if _, err := db.ExecContext(ctx, schema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to create audit table: %w", err)
+	}
+
+	return &PostgresSink{db: db}, nil
+}
+
+// Name implements Sink
+func (s *PostgresSink) Name() string {
+	return "postgres"
+}
+
+// Write implements Sink. A batch is inserted in one transaction.
+func (s *PostgresSink) Write(ctx context.Context, events []AuditEvent) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareContext(ctx, `
+		INSERT INTO audit_events (occurred_at, action, actor, resource, success, error, details, ip_address, user_agent, duration_ms, request_id, chain, seq, prev_hash, hash, salts)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)`)
+	if err != nil {
+		return fmt.Errorf("failed to prepare insert: %w", err)
+	}
+	defer stmt.Close()
+
+	for _, event := range events {
+		details, err := json.Marshal(event.Details)
+		if err != nil {
+			return fmt.Errorf("failed to encode details: %w", err)
+		}
+		if event.Details == nil {
+			details = []byte("{}")
+		}
+		salts, err := json.Marshal(event.Salts)
+		if err != nil {
+			return fmt.Errorf("failed to encode salts: %w", err)
+		}
+		if event.Salts == nil {
+			salts = []byte("{}")
+		}
+		if _, err := stmt.ExecContext(ctx,
+			event.Timestamp, event.Action, event.Actor, event.Resource, event.Success, event.Error,
+			details, event.IPAddress, event.UserAgent, event.Duration.Milliseconds(), event.RequestID,
+			event.Chain, int64(event.Seq), event.PrevHash, event.Hash, salts,
+		); err != nil {
+			return fmt.Errorf("failed to insert audit event: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit audit events: %w", err)
+	}
+	return nil
+}
+
+// Head implements ChainStore
+func (s *PostgresSink) Head(ctx context.Context, chain string) (uint64, string, error) {
+	var seq int64
+	var hash string
+	err := s.db.QueryRowContext(ctx, `SELECT seq, hash FROM audit_events WHERE chain = $1 ORDER BY seq DESC LIMIT 1`, chain).Scan(&seq, &hash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, "", nil
+	}
+	if err != nil {
+		return 0, "", fmt.Errorf("failed to read chain head: %w", err)
+	}
+	return uint64(seq), hash, nil
+}
+
+// Events implements ChainStore; rows come ordered by chain and sequence
+func (s *PostgresSink) Events(ctx context.Context, fn func(event AuditEvent, position string) error) error {
+	rows, err := s.db.QueryContext(ctx, `SELECT `+columns+` FROM audit_events ORDER BY chain, seq, id`)
+	if err != nil {
+		return fmt.Errorf("failed to query audit events: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		id, event, err := scanEvent(rows)
+		if err != nil {
+			return err
+		}
+		if err := fn(event, "id "+strconv.FormatInt(id, 10)); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// Sync implements Sink; committed rows are already durable
+func (s *PostgresSink) Sync() error {
+	return nil
+}
+
+// Close implements Sink
+func (s *PostgresSink) Close() error {
+	return s.db.Close()
+}
+
+// Helper functions
+
+// scanEvent reads an event row selected with columns
+func scanEvent(row interface{ Scan(...interface{}) error }) (int64, AuditEvent, error) {
+	var id, durationMs, seq int64
+	var details, salts []byte
+	var event AuditEvent
+	err := row.Scan(&id, &event.Timestamp, &event.Action, &event.Actor, &event.Resource, &event.Success, &event.Error,
+		&details, &event.IPAddress, &event.UserAgent, &durationMs, &event.RequestID,
+		&event.Chain, &seq, &event.PrevHash, &event.Hash, &event.Pruned, &salts)
+	if err != nil {
+		return 0, AuditEvent{}, fmt.Errorf("failed to read audit event: %w", err)
+	}
+	if err := json.Unmarshal(details, &event.Details); err != nil {
+		return 0, AuditEvent{}, fmt.Errorf("invalid details of audit event %d: %w", id, err)
+	}
+	if err := json.Unmarshal(salts, &event.Salts); err != nil {
+		return 0, AuditEvent{}, fmt.Errorf("invalid salts of audit event %d: %w", id, err)
+	}
+	if len(event.Salts) == 0 {
+		event.Salts = nil
+	}
+	event.Timestamp = event.Timestamp.UTC()
+	event.Duration = time.Duration(durationMs) * time.Millisecond
+	event.Seq = uint64(seq)
+	return id, event, nil
+}
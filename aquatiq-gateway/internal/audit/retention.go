@@ -0,0 +1,688 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Lifecycle runs are reported through audit with these actions
+const (
+	RetentionAction = "audit_retention"
+	ErasureAction   = "audit_erasure"
+)
+
+// RetentionPolicy sets how long events of an action category keep their content
+type RetentionPolicy struct {
+	Action string        // Action, or action prefix ending in *, e.g. "auth_*"
+	MaxAge time.Duration // 0 keeps the events
+}
+
+// LifecycleConfig holds retention, archival and erasure of the Postgres store.
+// Retention prunes events: their content is removed but their chain link is
+// kept, so the chain still verifies. Archival moves whole months to gzip JSON
+// lines files that "gateway audit verify -source archive" checks.
+type LifecycleConfig struct {
+	DefaultRetention time.Duration     // Age at which events no policy matches are pruned; 0 keeps them
+	Policies         []RetentionPolicy // An exact action wins over a prefix, a longer prefix over a shorter one
+	ArchiveDir       string            // Directory of monthly archives; empty disables archival
+	ArchiveAfter     time.Duration     // Age at which a month is moved to the archive
+	Interval         time.Duration     // Time between scheduled runs (default 24h)
+}
+
+// MinRetention returns the shortest retention that prunes events, or 0 when
+// none does
+func (c LifecycleConfig) MinRetention() time.Duration {
+	shortest := c.DefaultRetention
+	for _, policy := range c.Policies {
+		if policy.MaxAge > 0 && (shortest == 0 || policy.MaxAge < shortest) {
+			shortest = policy.MaxAge
+		}
+	}
+	return shortest
+}
+
+// LifecycleReport is the outcome of a retention run
+type LifecycleReport struct {
+	Pruned   map[string]int64 `json:"pruned"`   // Events pruned, by action
+	Archived int64            `json:"archived"` // Events moved to the archive
+	Files    []string         `json:"files"`    // Archives written
+	Expired  []string         `json:"expired"`  // Archives removed once every retention period passed
+}
+
+// Subject identifies whose personal fields an erasure replaces
+type Subject struct {
+	Actor     string // Actor as logged, e.g. a user or API key name
+	IPAddress string // Client address; erased in its masked form, which a truncated prefix shares with its network
+}
+
+// ErasureReport is the outcome of an erasure
+type ErasureReport struct {
+	Events         int64    `json:"events"`          // Events erased in the store
+	ArchivedEvents int64    `json:"archived_events"` // Events erased in archives
+	Files          []string `json:"files"`           // Archives rewritten
+}
+
+// Lifecycle runs retention, archival and erasure on the Postgres store
+type Lifecycle struct {
+	logger *AuditLogger
+	store  *PostgresSink
+	cfg    LifecycleConfig
+
+	mu     sync.Mutex         // One run or erasure at a time
+	cancel context.CancelFunc // Stops scheduled runs; set by Start
+	done   chan struct{}
+}
+
+// NewLifecycle returns the lifecycle of the logger's Postgres store
+func NewLifecycle(logger *AuditLogger, cfg LifecycleConfig) (*Lifecycle, error) {
+	store := logger.postgresStore()
+	if store == nil {
+		return nil, fmt.Errorf("audit lifecycle requires the postgres store")
+	}
+	if cfg.Interval <= 0 {
+		cfg.Interval = 24 * time.Hour
+	}
+	if cfg.ArchiveDir != "" {
+		if cfg.ArchiveAfter <= 0 {
+			return nil, fmt.Errorf("audit archival requires the age at which months are archived")
+		}
+		if err := os.MkdirAll(cfg.ArchiveDir, 0o750); err != nil {
+			return nil, fmt.Errorf("failed to create audit archive directory: %w", err)
+		}
+	}
+
+	return &Lifecycle{
+		logger: logger,
+		store:  store,
+		cfg:    cfg,
+	}, nil
+}
+
+// Start runs retention and archival a minute from now and then every interval
+func (l *Lifecycle) Start() {
+	stop, cancel := context.WithCancel(context.Background())
+	l.cancel, l.done = cancel, make(chan struct{})
+	go func() {
+		defer close(l.done)
+		timer := time.NewTimer(time.Minute)
+		defer timer.Stop()
+		for {
+			select {
+			case <-stop.Done():
+				return
+			case <-timer.C:
+				ctx, cancel := context.WithTimeout(stop, l.cfg.Interval)
+				if _, err := l.Run(ctx); err != nil {
+					l.logger.logger.Error("Audit retention run failed", zap.Error(err))
+				}
+				cancel()
+				timer.Reset(l.cfg.Interval)
+			}
+		}
+	}()
+}
+
+// MinRetention returns the shortest retention that prunes events, or 0 when
+// none does or l is nil
+func (l *Lifecycle) MinRetention() time.Duration {
+	if l == nil {
+		return 0
+	}
+	return l.cfg.MinRetention()
+}
+
+// Stop stops scheduled runs, cancelling one in progress, and waits for it to end
+func (l *Lifecycle) Stop() {
+	if l.cancel == nil {
+		return
+	}
+	l.cancel()
+	<-l.done
+}
+
+// Run prunes events past their retention, archives months past ArchiveAfter
+// and removes archives past every retention period
+func (l *Lifecycle) Run(ctx context.Context) (*LifecycleReport, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	start := time.Now()
+	// Buffered events are stored first so they are handled with the rest
+	l.logger.Sync()
+
+	report := &LifecycleReport{Pruned: make(map[string]int64)}
+	err := l.prune(ctx, start, report)
+	if err == nil && l.cfg.ArchiveDir != "" {
+		err = l.archive(ctx, start, report)
+		if err == nil {
+			err = l.expireArchives(start, report)
+		}
+	}
+
+	var pruned int64
+	actions := make([]string, 0, len(report.Pruned))
+	for action, n := range report.Pruned {
+		pruned += n
+		actions = append(actions, fmt.Sprintf("%s=%d", action, n))
+	}
+	sort.Strings(actions)
+	event := AuditEvent{
+		Timestamp: time.Now(),
+		Action:    RetentionAction,
+		Actor:     "gateway",
+		Resource:  "audit_events",
+		Success:   err == nil,
+		Duration:  time.Since(start),
+		Details: map[string]string{
+			"pruned":        fmt.Sprint(pruned),
+			"pruned_by":     strings.Join(actions, ","),
+			"archived":      fmt.Sprint(report.Archived),
+			"archive_files": strings.Join(report.Files, ","),
+			"expired_files": strings.Join(report.Expired, ","),
+		},
+	}
+	if err != nil {
+		event.Error = err.Error()
+	}
+	l.logger.LogEvent(event)
+
+	return report, err
+}
+
+// Erase replaces the subject's actor and masked IP address in the store and
+// the archives with their salted commitments and destroys the salts, which
+// keeps every chain verifiable. The erasure is reported with requester as actor.
+func (l *Lifecycle) Erase(ctx context.Context, subject Subject, requester string) (*ErasureReport, error) {
+	fields := make(map[string]string)
+	if subject.Actor != "" {
+		if strings.HasPrefix(subject.Actor, ErasedPrefix) {
+			return nil, fmt.Errorf("actor is already erased")
+		}
+		fields["actor"] = subject.Actor
+	}
+	if subject.IPAddress != "" {
+		if _, ok := parseClientIP(subject.IPAddress); !ok {
+			return nil, fmt.Errorf("invalid IP address")
+		}
+		fields["ip_address"] = l.logger.maskIP(subject.IPAddress)
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("an actor or IP address is required")
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	start := time.Now()
+	// Buffered events of the subject are stored first so they are erased too
+	l.logger.Sync()
+
+	report := &ErasureReport{}
+	events, err := l.store.erase(ctx, fields)
+	report.Events = events
+	if err == nil && l.cfg.ArchiveDir != "" {
+		err = l.eraseArchives(ctx, fields, report)
+	}
+
+	event := AuditEvent{
+		Timestamp: time.Now(),
+		Action:    ErasureAction,
+		Actor:     requester,
+		Resource:  "audit_events",
+		Success:   err == nil,
+		Duration:  time.Since(start),
+		Details: map[string]string{
+			"events":          fmt.Sprint(report.Events),
+			"archived_events": fmt.Sprint(report.ArchivedEvents),
+			"archive_files":   strings.Join(report.Files, ","),
+		},
+	}
+	// The report does not name the subject, only what identified it
+	erasedFields := make([]string, 0, len(fields))
+	for column := range fields {
+		erasedFields = append(erasedFields, column)
+	}
+	sort.Strings(erasedFields)
+	event.Details["fields"] = strings.Join(erasedFields, ",")
+	if err != nil {
+		event.Error = err.Error()
+	}
+	l.logger.LogEvent(event)
+
+	return report, err
+}
+
+// prune removes the content of events past the retention of their action
+func (l *Lifecycle) prune(ctx context.Context, now time.Time, report *LifecycleReport) error {
+	actions, err := l.store.actions(ctx)
+	if err != nil {
+		return err
+	}
+	for _, action := range actions {
+		maxAge := l.retention(action)
+		if maxAge <= 0 {
+			continue
+		}
+		n, err := l.store.prune(ctx, action, now.Add(-maxAge))
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			report.Pruned[action] = n
+		}
+	}
+	return nil
+}
+
+// retention returns the retention of an action: an exact policy, else the
+// longest matching prefix policy, else the default
+func (l *Lifecycle) retention(action string) time.Duration {
+	best, bestLen := l.cfg.DefaultRetention, -1
+	for _, policy := range l.cfg.Policies {
+		if policy.Action == action {
+			return policy.MaxAge
+		}
+		if prefix, ok := strings.CutSuffix(policy.Action, "*"); ok && strings.HasPrefix(action, prefix) && len(prefix) > bestLen {
+			best, bestLen = policy.MaxAge, len(prefix)
+		}
+	}
+	return best
+}
+
+// archive moves whole months older than ArchiveAfter to the archive, oldest first
+func (l *Lifecycle) archive(ctx context.Context, now time.Time, report *LifecycleReport) error {
+	cutoff := now.Add(-l.cfg.ArchiveAfter)
+	for {
+		oldest, ok, err := l.store.oldest(ctx)
+		if err != nil || !ok {
+			return err
+		}
+
+		// Events logged late go into the newest archive so archives stay in chain order
+		month := time.Date(oldest.Year(), oldest.Month(), 1, 0, 0, 0, 0, time.UTC)
+		if latest, ok, err := l.latestArchive(); err != nil {
+			return err
+		} else if ok && latest.After(month) {
+			month = latest
+		}
+		end := month.AddDate(0, 1, 0)
+		if end.After(cutoff) {
+			return nil
+		}
+
+		n, err := l.archiveMonth(ctx, month, end)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return nil
+		}
+		report.Archived += n
+		report.Files = append(report.Files, archivePath(l.cfg.ArchiveDir, month))
+	}
+}
+
+// archiveMonth writes the events before end to the month's archive and
+// deletes them from the store. Chained events are cut by sequence, so the
+// store keeps an unbroken tail of every chain. An existing archive of the
+// month, such as one left by an interrupted run, is extended.
+func (l *Lifecycle) archiveMonth(ctx context.Context, month, end time.Time) (int64, error) {
+	cuts, err := l.store.chainCuts(ctx, end)
+	if err != nil {
+		return 0, err
+	}
+
+	path := archivePath(l.cfg.ArchiveDir, month)
+	w, err := newArchiveWriter(path)
+	if err != nil {
+		return 0, err
+	}
+
+	// Events already archived are kept and not written twice
+	archived := make(map[string]bool)
+	err = readEvents(ctx, path, func(event AuditEvent, position string) error {
+		archived[archiveKey(event)] = true
+		return w.Write(event)
+	})
+	if err != nil {
+		w.Abort()
+		return 0, err
+	}
+
+	var n int64
+	err = l.store.archivable(ctx, end, cuts, func(event AuditEvent) error {
+		n++
+		if archived[archiveKey(event)] {
+			return nil
+		}
+		return w.Write(event)
+	})
+	if err != nil {
+		w.Abort()
+		return 0, err
+	}
+	if err := w.Commit(); err != nil {
+		return 0, err
+	}
+
+	// Only deleted once the archive is durable
+	if err := l.store.deleteArchived(ctx, end, cuts); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// expireArchives removes archives whose month ended before every retention
+// period; nothing expires while any period keeps events forever
+func (l *Lifecycle) expireArchives(now time.Time, report *LifecycleReport) error {
+	longest := l.cfg.DefaultRetention
+	if longest <= 0 {
+		return nil
+	}
+	for _, policy := range l.cfg.Policies {
+		if policy.MaxAge <= 0 {
+			return nil
+		}
+		longest = max(longest, policy.MaxAge)
+	}
+
+	files, err := NewArchiveStore(l.cfg.ArchiveDir).Files()
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		month, _ := archiveMonth(file)
+		if month.AddDate(0, 1, 0).Add(longest).After(now) {
+			break
+		}
+		if err := os.Remove(file); err != nil {
+			return fmt.Errorf("failed to remove expired audit archive: %w", err)
+		}
+		report.Expired = append(report.Expired, file)
+	}
+	return nil
+}
+
+// eraseArchives rewrites the archives holding events of the subject
+func (l *Lifecycle) eraseArchives(ctx context.Context, fields map[string]string, report *ErasureReport) error {
+	files, err := NewArchiveStore(l.cfg.ArchiveDir).Files()
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		matches := 0
+		err := readEvents(ctx, file, func(event AuditEvent, position string) error {
+			if eraseFields(&event, fields) {
+				matches++
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if matches == 0 {
+			continue
+		}
+
+		w, err := newArchiveWriter(file)
+		if err != nil {
+			return err
+		}
+		err = readEvents(ctx, file, func(event AuditEvent, position string) error {
+			eraseFields(&event, fields)
+			return w.Write(event)
+		})
+		if err == nil {
+			err = w.Commit()
+		} else {
+			w.Abort()
+		}
+		if err != nil {
+			return err
+		}
+		report.ArchivedEvents += int64(matches)
+		report.Files = append(report.Files, file)
+	}
+	return nil
+}
+
+// postgresStore returns the Postgres sink, or nil
+func (a *AuditLogger) postgresStore() *PostgresSink {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	for _, sink := range a.sinks {
+		if store, ok := sink.sink.(*PostgresSink); ok {
+			return store
+		}
+	}
+	return nil
+}
+
+// actions returns the actions of events that are not pruned; checkpoints and
+// dropped markers are never pruned
+func (s *PostgresSink) actions(ctx context.Context) ([]string, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT DISTINCT action FROM audit_events WHERE NOT pruned AND action NOT IN ($1, $2)`, CheckpointAction, DroppedAction)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit actions: %w", err)
+	}
+	defer rows.Close()
+
+	var actions []string
+	for rows.Next() {
+		var action string
+		if err := rows.Scan(&action); err != nil {
+			return nil, fmt.Errorf("failed to list audit actions: %w", err)
+		}
+		actions = append(actions, action)
+	}
+	return actions, rows.Err()
+}
+
+// prune removes the content of an action's events older than before; the
+// action, time, outcome and chain link are kept
+func (s *PostgresSink) prune(ctx context.Context, action string, before time.Time) (int64, error) {
+	result, err := s.db.ExecContext(ctx, `
+		UPDATE audit_events
+		SET actor = '', resource = '', error = '', details = '{}', ip_address = '', user_agent = '', request_id = '', salts = '{}', pruned = true
+		WHERE action = $1 AND occurred_at < $2 AND NOT pruned`, action, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune %s audit events: %w", action, err)
+	}
+	return result.RowsAffected()
+}
+
+// erase replaces the given column values with their erased form. Each
+// event's commitment depends on its own salt, so rows are erased one by one.
+func (s *PostgresSink) erase(ctx context.Context, fields map[string]string) (int64, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// An event can match both fields; it is erased once
+	erased := make(map[int64]AuditEvent)
+	for column, value := range fields {
+		// column is "actor" or "ip_address", never caller input
+		rows, err := tx.QueryContext(ctx, `SELECT `+columns+` FROM audit_events WHERE `+column+` = $1 FOR UPDATE`, value)
+		if err != nil {
+			return 0, fmt.Errorf("failed to erase audit events: %w", err)
+		}
+		for rows.Next() {
+			id, event, err := scanEvent(rows)
+			if err != nil {
+				rows.Close()
+				return 0, err
+			}
+			erased[id] = event
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return 0, fmt.Errorf("failed to erase audit events: %w", err)
+		}
+	}
+
+	for id, event := range erased {
+		eraseFields(&event, fields)
+		salts, err := json.Marshal(event.Salts)
+		if err != nil {
+			return 0, fmt.Errorf("failed to encode salts: %w", err)
+		}
+		if event.Salts == nil {
+			salts = []byte("{}")
+		}
+		if _, err := tx.ExecContext(ctx, `UPDATE audit_events SET actor = $2, ip_address = $3, salts = $4 WHERE id = $1`,
+			id, event.Actor, event.IPAddress, salts); err != nil {
+			return 0, fmt.Errorf("failed to erase audit events: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit erasure: %w", err)
+	}
+	return int64(len(erased)), nil
+}
+
+// oldest returns the time of the oldest stored event
+func (s *PostgresSink) oldest(ctx context.Context) (time.Time, bool, error) {
+	var oldest *time.Time
+	if err := s.db.QueryRowContext(ctx, `SELECT MIN(occurred_at) FROM audit_events`).Scan(&oldest); err != nil {
+		return time.Time{}, false, fmt.Errorf("failed to read oldest audit event: %w", err)
+	}
+	if oldest == nil {
+		return time.Time{}, false, nil
+	}
+	return oldest.UTC(), true, nil
+}
+
+// chainCuts returns, per chain, the last sequence of an event before end
+func (s *PostgresSink) chainCuts(ctx context.Context, end time.Time) (map[string]int64, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT chain, MAX(seq) FROM audit_events WHERE chain <> '' AND occurred_at < $1 GROUP BY chain`, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read audit chains: %w", err)
+	}
+	defer rows.Close()
+
+	cuts := make(map[string]int64)
+	for rows.Next() {
+		var chain string
+		var seq int64
+		if err := rows.Scan(&chain, &seq); err != nil {
+			return nil, fmt.Errorf("failed to read audit chains: %w", err)
+		}
+		cuts[chain] = seq
+	}
+	return cuts, rows.Err()
+}
+
+// archivable calls fn with the unchained events before end and every
+// chain's events up to its cut, each chain in sequence order
+func (s *PostgresSink) archivable(ctx context.Context, end time.Time, cuts map[string]int64, fn func(event AuditEvent) error) error {
+	if err := s.scanEvents(ctx, fn, `SELECT `+columns+` FROM audit_events WHERE chain = '' AND occurred_at < $1 ORDER BY id`, end); err != nil {
+		return err
+	}
+	for _, chain := range sortedKeys(cuts) {
+		if err := s.scanEvents(ctx, fn, `SELECT `+columns+` FROM audit_events WHERE chain = $1 AND seq <= $2 ORDER BY seq`, chain, cuts[chain]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteArchived deletes the events archivable selected
+func (s *PostgresSink) deleteArchived(ctx context.Context, end time.Time, cuts map[string]int64) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM audit_events WHERE chain = '' AND occurred_at < $1`, end); err != nil {
+		return fmt.Errorf("failed to delete archived audit events: %w", err)
+	}
+	for chain, seq := range cuts {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM audit_events WHERE chain = $1 AND seq <= $2`, chain, seq); err != nil {
+			return fmt.Errorf("failed to delete archived audit events: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit archived audit events: %w", err)
+	}
+	return nil
+}
+
+// scanEvents calls fn with every event a query selects
+func (s *PostgresSink) scanEvents(ctx context.Context, fn func(event AuditEvent) error, query string, args ...interface{}) error {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to query audit events: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		_, event, err := scanEvent(rows)
+		if err != nil {
+			return err
+		}
+		if err := fn(event); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// Helper functions
+
+// latestArchive returns the month of the newest archive
+func (l *Lifecycle) latestArchive() (time.Time, bool, error) {
+	files, err := NewArchiveStore(l.cfg.ArchiveDir).Files()
+	if err != nil || len(files) == 0 {
+		return time.Time{}, false, err
+	}
+	month, _ := archiveMonth(files[len(files)-1])
+	return month, true, nil
+}
+
+// archiveKey identifies an event across an archive and the store
+func archiveKey(event AuditEvent) string {
+	if event.Chain != "" {
+		return fmt.Sprintf("%s:%d", event.Chain, event.Seq)
+	}
+	data, _ := json.Marshal(event)
+	return string(data)
+}
+
+// eraseFields erases the fields of an event that hold the given values and
+// reports whether any did
+func eraseFields(event *AuditEvent, fields map[string]string) bool {
+	erased := false
+	if actor, ok := fields["actor"]; ok && event.Actor == actor {
+		eraseField(event, "actor")
+		erased = true
+	}
+	if ip, ok := fields["ip_address"]; ok && event.IPAddress == ip {
+		eraseField(event, "ip_address")
+		erased = true
+	}
+	return erased
+}
+
+// sortedKeys returns the keys of a map in order
+func sortedKeys(m map[string]int64) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
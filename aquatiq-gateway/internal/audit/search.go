@@ -0,0 +1,180 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Filter selects stored events; empty fields match everything. Actor,
+// Action and Resource match exactly unless they end in *, which matches a prefix.
+type Filter struct {
+	Actor    string
+	Action   string
+	Resource string
+	Success  *bool
+	IPPrefix string    // Prefix of the stored (masked) address
+	From     time.Time // Inclusive
+	To       time.Time // Exclusive
+}
+
+// Record is a stored event with its store ID
+type Record struct {
+	ID    int64
+	Event AuditEvent
+}
+
+// Page is one page of search results, newest first
+type Page struct {
+	Records   []Record
+	NextToken string // Passed back to get the next page; empty on the last page
+}
+
+// Searcher is a store whose events can be searched and exported
+type Searcher interface {
+	// Search returns up to limit matching events, newest first, after the page token
+	Search(ctx context.Context, filter Filter, limit int, pageToken string) (*Page, error)
+	// Export calls fn with every matching event, oldest first, until fn returns an error
+	Export(ctx context.Context, filter Filter, fn func(record Record) error) error
+}
+
+// Searcher returns the first sink that can be searched, or nil
+func (a *AuditLogger) Searcher() Searcher {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	for _, sink := range a.sinks {
+		if searcher, ok := sink.sink.(Searcher); ok {
+			return searcher
+		}
+	}
+	return nil
+}
+
+// Search implements Searcher. Pages are keyed by event ID, so events stored
+// while paging never shift later pages.
+func (s *PostgresSink) Search(ctx context.Context, filter Filter, limit int, pageToken string) (*Page, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+	if limit > 1000 {
+		limit = 1000
+	}
+
+	where, args := filterClause(filter)
+	if pageToken != "" {
+		before, err := strconv.ParseInt(pageToken, 10, 64)
+		if err != nil || before <= 0 {
+			return nil, fmt.Errorf("invalid page token")
+		}
+		args = append(args, before)
+		where = append(where, fmt.Sprintf("id < $%d", len(args)))
+	}
+
+	query := `SELECT ` + columns + ` FROM audit_events`
+	if len(where) > 0 {
+		query += ` WHERE ` + strings.Join(where, " AND ")
+	}
+	// One extra row tells whether there is a next page
+	args = append(args, limit+1)
+	query += fmt.Sprintf(` ORDER BY id DESC LIMIT $%d`, len(args))
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search audit events: %w", err)
+	}
+	defer rows.Close()
+
+	page := &Page{}
+	for rows.Next() {
+		id, event, err := scanEvent(rows)
+		if err != nil {
+			return nil, err
+		}
+		page.Records = append(page.Records, Record{ID: id, Event: event})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to search audit events: %w", err)
+	}
+
+	if len(page.Records) > limit {
+		page.Records = page.Records[:limit]
+		page.NextToken = strconv.FormatInt(page.Records[limit-1].ID, 10)
+	}
+	return page, nil
+}
+
+// Export implements Searcher
+func (s *PostgresSink) Export(ctx context.Context, filter Filter, fn func(record Record) error) error {
+	where, args := filterClause(filter)
+	query := `SELECT ` + columns + ` FROM audit_events`
+	if len(where) > 0 {
+		query += ` WHERE ` + strings.Join(where, " AND ")
+	}
+	query += ` ORDER BY id`
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to export audit events: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		id, event, err := scanEvent(rows)
+		if err != nil {
+			return err
+		}
+		if err := fn(Record{ID: id, Event: event}); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// Helper functions
+
+// filterClause turns a filter into SQL conditions and their arguments
+func filterClause(filter Filter) ([]string, []interface{}) {
+	var where []string
+	var args []interface{}
+	match := func(column, value string) {
+		if value == "" {
+			return
+		}
+		if prefix, ok := strings.CutSuffix(value, "*"); ok {
+			args = append(args, likePrefix(prefix))
+			where = append(where, fmt.Sprintf("%s LIKE $%d", column, len(args)))
+			return
+		}
+		args = append(args, value)
+		where = append(where, fmt.Sprintf("%s = $%d", column, len(args)))
+	}
+
+	match("actor", filter.Actor)
+	match("action", filter.Action)
+	match("resource", filter.Resource)
+	if filter.IPPrefix != "" {
+		args = append(args, likePrefix(filter.IPPrefix))
+		where = append(where, fmt.Sprintf("ip_address LIKE $%d", len(args)))
+	}
+	if filter.Success != nil {
+		args = append(args, *filter.Success)
+		where = append(where, fmt.Sprintf("success = $%d", len(args)))
+	}
+	if !filter.From.IsZero() {
+		args = append(args, filter.From)
+		where = append(where, fmt.Sprintf("occurred_at >= $%d", len(args)))
+	}
+	if !filter.To.IsZero() {
+		args = append(args, filter.To)
+		where = append(where, fmt.Sprintf("occurred_at < $%d", len(args)))
+	}
+	return where, args
+}
+
+// likePrefix escapes a value for a LIKE prefix match
+func likePrefix(prefix string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(prefix) + "%"
+}
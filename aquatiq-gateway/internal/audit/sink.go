@@ -0,0 +1,401 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Backpressure policies for a full sink buffer
+const (
+	BackpressureBlock = "block" // Wait up to BlockTimeout for room, then drop
+	BackpressureDrop  = "drop"  // Drop the event right away
+)
+
+// writeAttempts is how often a batch is written before it is given up
+const writeAttempts = 3
+
+// Sink stores audit events outside the process log
+type Sink interface {
+	// Name identifies the sink in stats and errors
+	Name() string
+	// Write stores a batch of events in order
+	Write(ctx context.Context, events []AuditEvent) error
+	// Sync makes written events durable
+	Sync() error
+	// Close releases the sink; it is not written to afterwards
+	Close() error
+}
+
+// SinkOptions holds how events are buffered in front of a sink
+type SinkOptions struct {
+	BufferSize    int           // Events queued before backpressure applies (default 10000)
+	BatchSize     int           // Events written per batch (default 200)
+	FlushInterval time.Duration // Longest time an event waits in the buffer (default 1s)
+	Backpressure  string        // block (default) or drop
+	BlockTimeout  time.Duration // Longest time LogEvent waits for room with block (default 100ms)
+}
+
+// SinkStats holds the state of a sink's buffer
+type SinkStats struct {
+	Name        string     `json:"name"`
+	Buffered    int        `json:"buffered"`
+	Capacity    int        `json:"capacity"`
+	Written     uint64     `json:"written"`
+	Dropped     uint64     `json:"dropped"` // Rejected by a full buffer
+	Failed      uint64     `json:"failed"`  // Lost after all write attempts
+	LastError   string     `json:"last_error,omitempty"`
+	LastErrorAt *time.Time `json:"last_error_at,omitempty"`
+}
+
+// bufferedSink queues events for a sink and writes them in batches from
+// its own goroutine, so a slow sink never holds up the request path
+type bufferedSink struct {
+	sink   Sink
+	opts   SinkOptions
+	logger *zap.Logger
+
+	events  chan AuditEvent
+	flushes chan chan struct{}
+	stop    chan struct{}
+	stopped chan struct{}
+	once    sync.Once
+	turn    chan struct{} // Closed once the last event given a turn was enqueued
+
+	written atomic.Uint64
+	dropped atomic.Uint64
+	failed  atomic.Uint64
+
+	mu          sync.Mutex
+	lastError   string
+	lastErrorAt time.Time
+	missed      []seqRange // Chained events the sink lost, not yet in a dropped marker
+	markedAt    time.Time  // When the last dropped marker was sent
+}
+
+// turn is an event's place in a sink's queue: it is enqueued once the event
+// before it was
+type turn struct {
+	prev chan struct{}
+	done chan struct{}
+}
+
+// seqRange is a run of chained events a sink lost, with the hash of the last
+// one so a verifier can link the events after it
+type seqRange struct {
+	First    uint64
+	Last     uint64
+	LastHash string
+}
+
+// newBufferedSink starts the writer goroutine of a sink
+func newBufferedSink(sink Sink, opts SinkOptions, logger *zap.Logger) *bufferedSink {
+	if opts.BufferSize <= 0 {
+		opts.BufferSize = 10000
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 200
+	}
+	if opts.FlushInterval <= 0 {
+		opts.FlushInterval = time.Second
+	}
+	if opts.Backpressure == "" {
+		opts.Backpressure = BackpressureBlock
+	}
+	if opts.BlockTimeout <= 0 {
+		opts.BlockTimeout = 100 * time.Millisecond
+	}
+
+	b := &bufferedSink{
+		sink:    sink,
+		opts:    opts,
+		logger:  logger,
+		events:  make(chan AuditEvent, opts.BufferSize),
+		flushes: make(chan chan struct{}),
+		stop:    make(chan struct{}),
+		stopped: make(chan struct{}),
+		turn:    make(chan struct{}),
+	}
+	close(b.turn)
+	go b.run()
+	return b
+}
+
+// nextTurn gives an event its place in the queue. Called with the logger's
+// order lock held, so turns follow the chain.
+func (b *bufferedSink) nextTurn() turn {
+	t := turn{prev: b.turn, done: make(chan struct{})}
+	b.turn = t.done
+	return t
+}
+
+// enqueue hands an event to the writer once the event before it was,
+// applying the backpressure policy when the buffer is full. With block it
+// waits until BlockTimeout after since, so events queued behind a full buffer
+// wait no longer than the first. It reports whether the event was queued.
+func (b *bufferedSink) enqueue(event AuditEvent, t turn, since time.Time) bool {
+	defer close(t.done)
+	<-t.prev
+
+	select {
+	case b.events <- event:
+		return true
+	case <-b.stopped:
+		b.drop(event)
+		return false
+	default:
+	}
+
+	if b.opts.Backpressure == BackpressureBlock {
+		timer := time.NewTimer(time.Until(since.Add(b.opts.BlockTimeout)))
+		defer timer.Stop()
+		select {
+		case b.events <- event:
+			return true
+		case <-timer.C:
+		case <-b.stopped:
+		}
+	}
+
+	b.drop(event)
+	return false
+}
+
+// drop counts an event the buffer had no room for
+func (b *bufferedSink) drop(event AuditEvent) {
+	b.miss(event)
+
+	// Warn once per thousand drops instead of per event
+	if b.dropped.Add(1)%1000 == 1 {
+		b.logger.Warn("audit sink buffer full, dropping events",
+			zap.String("sink", b.sink.Name()),
+			zap.Uint64("dropped", b.dropped.Load()),
+		)
+	}
+}
+
+// miss records a chained event the sink lost, so a dropped marker can
+// account for the gap it leaves. A lost marker of this sink takes the events
+// it accounted for with it, so they are recorded again.
+func (b *bufferedSink) miss(event AuditEvent) {
+	if event.Seq == 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.missed = append(b.missed, seqRange{First: event.Seq, Last: event.Seq, LastHash: event.Hash})
+	if event.Action == DroppedAction && event.Details["sink"] == b.sink.Name() {
+		ranges, _ := parseSeqRanges(event.Details["missed"])
+		b.missed = append(b.missed, ranges...)
+	}
+	b.missed = mergeSeqRanges(b.missed)
+}
+
+// takeMissed returns the lost events a dropped marker should account for
+// now and forgets them; miss records them again if the marker is lost too. Unless force is set, markers are
+// sent at most once per flush interval and only while the buffer has room.
+func (b *bufferedSink) takeMissed(force bool) []seqRange {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.missed) == 0 {
+		return nil
+	}
+	if !force && (time.Since(b.markedAt) < b.opts.FlushInterval || len(b.events) == cap(b.events)) {
+		return nil
+	}
+
+	missed := b.missed
+	b.missed = nil
+	b.markedAt = time.Now()
+	return missed
+}
+
+// flush writes everything queued so far and syncs the sink
+func (b *bufferedSink) flush() {
+	ack := make(chan struct{})
+	select {
+	case b.flushes <- ack:
+		<-ack
+	case <-b.stopped:
+	}
+}
+
+// close writes what is queued, stops the writer and closes the sink
+func (b *bufferedSink) close() {
+	b.once.Do(func() {
+		close(b.stop)
+		<-b.stopped
+		if err := b.sink.Close(); err != nil {
+			b.fail(0, err)
+		}
+	})
+}
+
+// stats returns the buffer state
+func (b *bufferedSink) stats() SinkStats {
+	stats := SinkStats{
+		Name:     b.sink.Name(),
+		Buffered: len(b.events),
+		Capacity: cap(b.events),
+		Written:  b.written.Load(),
+		Dropped:  b.dropped.Load(),
+		Failed:   b.failed.Load(),
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.lastError != "" {
+		at := b.lastErrorAt
+		stats.LastError = b.lastError
+		stats.LastErrorAt = &at
+	}
+	return stats
+}
+
+// run batches queued events until the sink is closed
+func (b *bufferedSink) run() {
+	defer close(b.stopped)
+
+	ticker := time.NewTicker(b.opts.FlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]AuditEvent, 0, b.opts.BatchSize)
+	for {
+		select {
+		case event := <-b.events:
+			batch = append(batch, event)
+			if len(batch) >= b.opts.BatchSize {
+				batch = b.write(batch)
+			}
+		case <-ticker.C:
+			batch = b.write(batch)
+		case ack := <-b.flushes:
+			batch = b.write(b.drain(batch))
+			if err := b.sink.Sync(); err != nil {
+				b.fail(0, err)
+			}
+			close(ack)
+		case <-b.stop:
+			b.write(b.drain(batch))
+			if err := b.sink.Sync(); err != nil {
+				b.fail(0, err)
+			}
+			return
+		}
+	}
+}
+
+// drain moves queued events into the batch, writing full batches on the way
+func (b *bufferedSink) drain(batch []AuditEvent) []AuditEvent {
+	for {
+		select {
+		case event := <-b.events:
+			batch = append(batch, event)
+			if len(batch) >= b.opts.BatchSize {
+				batch = b.write(batch)
+			}
+		default:
+			return batch
+		}
+	}
+}
+
+// write stores a batch, retrying with backoff, and returns it emptied
+func (b *bufferedSink) write(batch []AuditEvent) []AuditEvent {
+	if len(batch) == 0 {
+		return batch
+	}
+
+	var err error
+	for attempt := 0; attempt < writeAttempts; attempt++ {
+		if attempt > 0 {
+			time.Sleep(time.Duration(attempt) * 200 * time.Millisecond)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		err = b.sink.Write(ctx, batch)
+		cancel()
+		if err == nil {
+			b.written.Add(uint64(len(batch)))
+			return batch[:0]
+		}
+	}
+
+	b.fail(len(batch), err)
+	for _, event := range batch {
+		b.miss(event)
+	}
+	return batch[:0]
+}
+
+// fail records a sink error; lost counts the events it cost
+func (b *bufferedSink) fail(lost int, err error) {
+	b.failed.Add(uint64(lost))
+
+	b.mu.Lock()
+	b.lastError = err.Error()
+	b.lastErrorAt = time.Now()
+	b.mu.Unlock()
+
+	// Not an audit event: it would be queued behind the failing sink
+	b.logger.Error("audit sink failed",
+		zap.String("sink", b.sink.Name()),
+		zap.Int("lost_events", lost),
+		zap.Error(err),
+	)
+}
+
+// mergeSeqRanges sorts ranges and joins those that touch
+func mergeSeqRanges(ranges []seqRange) []seqRange {
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].First < ranges[j].First })
+
+	merged := ranges[:0]
+	for _, r := range ranges {
+		if n := len(merged); n > 0 && r.First <= merged[n-1].Last+1 {
+			if r.Last > merged[n-1].Last {
+				merged[n-1].Last, merged[n-1].LastHash = r.Last, r.LastHash
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// formatSeqRanges encodes ranges for a dropped marker, e.g. "41-45:<hash>,50-50:<hash>"
+func formatSeqRanges(ranges []seqRange) string {
+	parts := make([]string, len(ranges))
+	for i, r := range ranges {
+		parts[i] = strconv.FormatUint(r.First, 10) + "-" + strconv.FormatUint(r.Last, 10) + ":" + r.LastHash
+	}
+	return strings.Join(parts, ",")
+}
+
+// parseSeqRanges decodes the ranges of a dropped marker
+func parseSeqRanges(encoded string) ([]seqRange, error) {
+	var ranges []seqRange
+	for _, part := range strings.Split(encoded, ",") {
+		bounds, hash, ok := strings.Cut(part, ":")
+		first, last, ok2 := strings.Cut(bounds, "-")
+		if !ok || !ok2 || hash == "" {
+			return nil, fmt.Errorf("invalid range %q", part)
+		}
+		r := seqRange{LastHash: hash}
+		var err error
+		if r.First, err = strconv.ParseUint(first, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid range %q", part)
+		}
+		if r.Last, err = strconv.ParseUint(last, 10, 64); err != nil || r.Last < r.First || r.First == 0 {
+			return nil, fmt.Errorf("invalid range %q", part)
+		}
+		ranges = append(ranges, r)
+	}
+	return ranges, nil
+}
@@ -0,0 +1,273 @@
+package audit
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// ChainStore is a sink whose chained events can be read back
+type ChainStore interface {
+	// Head returns the sequence and hash of the last stored event of a chain
+	Head(ctx context.Context, chain string) (uint64, string, error)
+	// Events calls fn with every stored event in chain order, with a
+	// position such as "audit.jsonl:12" or "id 40", until fn returns an error
+	Events(ctx context.Context, fn func(event AuditEvent, position string) error) error
+}
+
+// VerifyReport is the outcome of verifying a store's hash chain
+type VerifyReport struct {
+	Source      string        `json:"source"`
+	OK          bool          `json:"ok"`
+	Events      uint64        `json:"events"`      // Chained events checked
+	Unchained   uint64        `json:"unchained"`   // Events written before chaining was enabled
+	Checkpoints uint64        `json:"checkpoints"` // Checkpoints with a valid signature
+	Unverified  uint64        `json:"unverified"`  // Checkpoints not checked for lack of a public key
+	Pruned      uint64        `json:"pruned"`      // Events whose content was removed by retention; their links and age are checked
+	Dropped     uint64        `json:"dropped"`     // Events the sink lost, accounted for by a dropped marker
+	Chains      []ChainReport `json:"chains"`
+	Broken      *BrokenLink   `json:"broken,omitempty"` // First broken link; verification stops there
+}
+
+// ChainReport describes one verified chain
+type ChainReport struct {
+	ID       string `json:"id"`
+	FirstSeq uint64 `json:"first_seq"` // Above 1 when older events were rotated or archived away
+	LastSeq  uint64 `json:"last_seq"`
+	LastHash string `json:"last_hash"`
+}
+
+// BrokenLink is where a chain stops proving that events were not altered or removed
+type BrokenLink struct {
+	Chain    string `json:"chain"`
+	Seq      uint64 `json:"seq"`
+	Position string `json:"position"`
+	Reason   string `json:"reason"`
+}
+
+// VerifyOptions holds what Verify checks events against
+type VerifyOptions struct {
+	PublicKey    ed25519.PublicKey // Checks checkpoint signatures; without it checkpoints are counted as unverified
+	MinRetention time.Duration     // Shortest retention configured; events pruned younger than it break the chain (0 skips the check)
+	Sink         string            // Sink that wrote the store; only its dropped markers account for missing events
+}
+
+// gap is a run of events missing from a store that no dropped marker
+// accounted for yet
+type gap struct {
+	chain    string
+	first    uint64
+	last     uint64
+	next     string // PrevHash of the event after the gap; empty once a marker covered the end
+	seq      uint64 // Event after the gap, where it is reported
+	position string
+	events   uint64 // Events checked before the gap
+}
+
+// errBroken stops reading a store at the first broken link
+var errBroken = errors.New("broken link")
+
+// Verify walks a store and checks every event's hash, its link to the event
+// before it and the signature of every checkpoint. Pruned events have no
+// content to hash, so they must have none left and be old enough to have been
+// pruned by retention. Events missing from the store break the chain unless a
+// dropped marker of the store's sink, later in the chain, accounts for them.
+func Verify(ctx context.Context, source string, store ChainStore, opts VerifyOptions) (*VerifyReport, error) {
+	report := &VerifyReport{Source: source}
+	publicKey := opts.PublicKey
+	chains := make(map[string]*ChainReport)
+	var missing []gap
+
+	err := store.Events(ctx, func(event AuditEvent, position string) error {
+		broken := func(format string, args ...interface{}) error {
+			report.Broken = &BrokenLink{
+				Chain:    event.Chain,
+				Seq:      event.Seq,
+				Position: position,
+				Reason:   fmt.Sprintf(format, args...),
+			}
+			return errBroken
+		}
+
+		if event.Hash == "" {
+			// Only events from before chaining was enabled may lack a hash
+			if report.Events > 0 {
+				return broken("event is not chained")
+			}
+			report.Unchained++
+			return nil
+		}
+		if event.Pruned {
+			// The flag is not covered by the hash, so it is only believed for
+			// events retention could have pruned
+			if event.Action == CheckpointAction {
+				return broken("checkpoint was pruned")
+			}
+			if event.Action == DroppedAction {
+				return broken("dropped marker was pruned")
+			}
+			if hasContent(event) {
+				return broken("pruned event still has content: event was altered")
+			}
+			if opts.MinRetention > 0 && time.Since(event.Timestamp) < opts.MinRetention {
+				return broken("event was pruned before the shortest retention of %s passed", opts.MinRetention)
+			}
+		} else if hash := EventHash(event); hash != event.Hash {
+			return broken("hash mismatch: event was altered")
+		}
+
+		c, seen := chains[event.Chain]
+		switch {
+		case !seen:
+			if event.Seq == 1 && event.PrevHash != "" {
+				return broken("first event of the chain links to a previous hash")
+			}
+			c = &ChainReport{ID: event.Chain, FirstSeq: event.Seq}
+			chains[event.Chain] = c
+		case event.Seq <= c.LastSeq:
+			return broken("sequence %d follows %d: event was duplicated, reordered or the chain restarted", event.Seq, c.LastSeq)
+		case event.Seq > c.LastSeq+1:
+			// The link is checked against a dropped marker instead
+			missing = append(missing, gap{
+				chain:    event.Chain,
+				first:    c.LastSeq + 1,
+				last:     event.Seq - 1,
+				next:     event.PrevHash,
+				seq:      event.Seq,
+				position: position,
+				events:   report.Events,
+			})
+		case event.PrevHash != c.LastHash:
+			return broken("previous hash does not match event %d", c.LastSeq)
+		}
+
+		if event.Action == CheckpointAction {
+			if reason := verifyCheckpoint(event, publicKey); reason != "" {
+				return broken("%s", reason)
+			}
+			if publicKey != nil {
+				report.Checkpoints++
+			} else {
+				report.Unverified++
+			}
+		}
+		if event.Action == DroppedAction && event.Details["sink"] == opts.Sink {
+			var reason string
+			var dropped uint64
+			missing, dropped, reason = applyDropped(event, missing, publicKey)
+			if reason != "" {
+				return broken("%s", reason)
+			}
+			report.Dropped += dropped
+		}
+
+		if event.Pruned {
+			report.Pruned++
+		}
+		c.LastSeq, c.LastHash = event.Seq, event.Hash
+		report.Events++
+		return nil
+	})
+	if err != nil && !errors.Is(err, errBroken) {
+		return nil, err
+	}
+
+	// An unaccounted gap comes before any later broken link
+	if len(missing) > 0 {
+		g := missing[0]
+		reason := fmt.Sprintf("events %d to %d are missing", g.first, g.last)
+		if g.first == g.last {
+			reason = fmt.Sprintf("event %d is missing", g.first)
+		}
+		report.Broken = &BrokenLink{Chain: g.chain, Seq: g.seq, Position: g.position, Reason: reason}
+		report.Events = g.events
+	}
+
+	for _, c := range chains {
+		report.Chains = append(report.Chains, *c)
+	}
+	sort.Slice(report.Chains, func(i, j int) bool { return report.Chains[i].ID < report.Chains[j].ID })
+	report.OK = report.Broken == nil
+	return report, nil
+}
+
+// Helper functions
+
+// hasContent reports whether an event has any of the fields retention removes
+func hasContent(event AuditEvent) bool {
+	return event.Actor != "" || event.Resource != "" || event.Error != "" || len(event.Details) > 0 ||
+		event.IPAddress != "" || event.UserAgent != "" || event.RequestID != "" || len(event.Salts) > 0
+}
+
+// applyDropped removes the events a dropped marker accounts for from the gaps
+// of its chain. A range that ends a gap must end with the event the one after
+// the gap links to. It returns the remaining gaps, the events accounted for
+// and why the marker is not valid, or "" when it is.
+func applyDropped(event AuditEvent, missing []gap, publicKey ed25519.PublicKey) ([]gap, uint64, string) {
+	if publicKey != nil {
+		if event.Details["key_id"] != KeyID(publicKey) {
+			return missing, 0, "dropped marker was signed with another key (" + event.Details["key_id"] + ")"
+		}
+		signature, err := base64.StdEncoding.DecodeString(event.Details["signature"])
+		if err != nil || !ed25519.Verify(publicKey, droppedMessage(event.Chain, event.Seq, event.Details["sink"], event.Details["missed"]), signature) {
+			return missing, 0, "invalid dropped marker signature"
+		}
+	}
+	ranges, err := parseSeqRanges(event.Details["missed"])
+	if err != nil {
+		return missing, 0, "invalid dropped marker: " + err.Error()
+	}
+
+	var dropped uint64
+	for _, r := range ranges {
+		remaining := make([]gap, 0, len(missing)+1)
+		for _, g := range missing {
+			lo, hi := max(g.first, r.First), min(g.last, r.Last)
+			if g.chain != event.Chain || lo > hi {
+				remaining = append(remaining, g)
+				continue
+			}
+			if r.Last == g.last && g.next != "" && r.LastHash != g.next {
+				return missing, 0, fmt.Sprintf("dropped marker does not link to event %d", g.seq)
+			}
+			dropped += hi - lo + 1
+			if lo > g.first {
+				left := g
+				left.last, left.next = lo-1, ""
+				remaining = append(remaining, left)
+			}
+			if hi < g.last {
+				right := g
+				right.first = hi + 1
+				remaining = append(remaining, right)
+			}
+		}
+		missing = remaining
+	}
+	return missing, dropped, ""
+}
+
+// verifyCheckpoint checks that a checkpoint vouches for the event before it;
+// it returns why it does not, or "" when it does
+func verifyCheckpoint(event AuditEvent, publicKey ed25519.PublicKey) string {
+	seq, err := strconv.ParseUint(event.Details["checkpoint_seq"], 10, 64)
+	if err != nil || seq != event.Seq-1 || event.Details["checkpoint_hash"] != event.PrevHash {
+		return "checkpoint does not cover the event before it"
+	}
+	if publicKey == nil {
+		return ""
+	}
+	if event.Details["key_id"] != KeyID(publicKey) {
+		return "checkpoint was signed with another key (" + event.Details["key_id"] + ")"
+	}
+	signature, err := base64.StdEncoding.DecodeString(event.Details["signature"])
+	if err != nil || !ed25519.Verify(publicKey, checkpointMessage(event.Chain, seq, event.PrevHash), signature) {
+		return "invalid checkpoint signature"
+	}
+	return ""
+}
@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"context"
 	"crypto/subtle"
 	"encoding/json"
 	"net/http"
@@ -27,6 +28,25 @@ type APIKey struct {
 	Enabled     bool       `json:"enabled"`
 }
 
+// apiKeyContextKey stores the authenticated API key in the request context
+type apiKeyContextKey struct{}
+
+// APIKeyFromContext returns the API key that authenticated a request
+func APIKeyFromContext(ctx context.Context) (APIKey, bool) {
+	key, ok := ctx.Value(apiKeyContextKey{}).(APIKey)
+	return key, ok
+}
+
+// HasScope reports whether a key grants a scope
+func (k APIKey) HasScope(scope string) bool {
+	for _, s := range k.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
 // Config holds API key authenticator configuration
 type Config struct {
 	Keys        []APIKey
@@ -87,13 +107,15 @@ func (a *APIKeyAuthenticator) Middleware(next http.Handler) http.Handler {
 				Success:   true,
 				IPAddress: getIPAddress(r),
 				UserAgent: r.UserAgent(),
+				RequestID: audit.RequestIDFromContext(r.Context()),
 			})
 		}
 
 		// Add key info to request context for downstream handlers
-		// (We can add this later if needed)
+		ctx := context.WithValue(r.Context(), apiKeyContextKey{}, key)
+		ctx = audit.WithActor(ctx, key.Name) // Picked up by the audit logger
 
-		next.ServeHTTP(w, r)
+		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
 
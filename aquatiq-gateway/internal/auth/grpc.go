@@ -0,0 +1,122 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aquatiq/integration-gateway/internal/audit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryInterceptor requires an API key with the scopes of the longest rule
+// prefix matching the full method name, e.g.
+// "/aquatiq.gateway.audit.v1.AuditService/": {"audit:read"}. Methods no rule
+// matches pass through unauthenticated.
+func (a *APIKeyAuthenticator) UnaryInterceptor(rules map[string][]string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := a.authorizeRPC(ctx, info.FullMethod, rules)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor is UnaryInterceptor for streaming methods
+func (a *APIKeyAuthenticator) StreamInterceptor(rules map[string][]string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authorizeRPC(ss.Context(), info.FullMethod, rules)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authenticatedStream carries the authenticated key in its context
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the context with the authenticated key
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+// authorizeRPC checks the API key in the call metadata against the scopes
+// the method requires and adds the key to the context
+func (a *APIKeyAuthenticator) authorizeRPC(ctx context.Context, method string, rules map[string][]string) (context.Context, error) {
+	var scopes []string
+	longest := -1
+	for prefix, required := range rules {
+		if strings.HasPrefix(method, prefix) && len(prefix) > longest {
+			scopes, longest = required, len(prefix)
+		}
+	}
+	if longest < 0 {
+		return ctx, nil
+	}
+
+	apiKey := extractRPCKey(ctx)
+	if apiKey == "" {
+		a.logRPCAuthFailure(ctx, method, "missing_api_key")
+		return nil, status.Error(codes.Unauthenticated, "API key is required")
+	}
+	key, valid := a.validateAPIKey(apiKey)
+	if !valid {
+		a.logRPCAuthFailure(ctx, method, "invalid_api_key")
+		return nil, status.Error(codes.Unauthenticated, "Invalid API key")
+	}
+	if key.ExpiresAt != nil && time.Now().After(*key.ExpiresAt) {
+		a.logRPCAuthFailure(ctx, method, "expired_api_key")
+		return nil, status.Error(codes.Unauthenticated, "API key has expired")
+	}
+	if !a.hasScopes(key, scopes) {
+		a.logRPCAuthFailure(ctx, method, "insufficient_scopes")
+		return nil, status.Error(codes.PermissionDenied, "Insufficient permissions")
+	}
+
+	return audit.WithActor(context.WithValue(ctx, apiKeyContextKey{}, key), key.Name), nil
+}
+
+// extractRPCKey reads the API key from x-api-key or bearer authorization metadata
+func extractRPCKey(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get("x-api-key"); len(values) > 0 && values[0] != "" {
+		return values[0]
+	}
+	if values := md.Get("authorization"); len(values) > 0 && strings.HasPrefix(values[0], "Bearer ") {
+		return strings.TrimPrefix(values[0], "Bearer ")
+	}
+	return ""
+}
+
+// logRPCAuthFailure logs a rejected call
+func (a *APIKeyAuthenticator) logRPCAuthFailure(ctx context.Context, method, reason string) {
+	if a.audit == nil {
+		return
+	}
+	ip := ""
+	if p, ok := peer.FromContext(ctx); ok {
+		ip = p.Addr.String()
+	}
+	a.audit.LogEvent(audit.AuditEvent{
+		Timestamp: time.Now(),
+		Action:    "auth_failure",
+		Actor:     "unknown",
+		Resource:  method,
+		Success:   false,
+		IPAddress: ip,
+		Error:     reason,
+		RequestID: audit.RequestIDFromContext(ctx),
+	})
+}
@@ -0,0 +1,428 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aquatiq/integration-gateway/internal/audit"
+	"github.com/aquatiq/integration-gateway/internal/cache"
+)
+
+// tenantSeparator separates the provider from the tenant or user in token keys
+const tenantSeparator = ":"
+
+// TokenKey returns the key of a tenant's or user's token for a provider.
+// An empty tenant selects the provider-wide token used by the integration clients.
+func TokenKey(provider, tenant string) string {
+	if tenant == "" {
+		return provider
+	}
+	return provider + tenantSeparator + tenant
+}
+
+// splitTokenKey returns the provider and tenant of a token key
+func splitTokenKey(key string) (provider, tenant string) {
+	provider, tenant, _ = strings.Cut(key, tenantSeparator)
+	return provider, tenant
+}
+
+// ProviderConfig describes how to obtain OAuth2 tokens for a service
+type ProviderConfig struct {
+	Name         string
+	TokenURL     string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+}
+
+// TokenManagerConfig holds token manager configuration
+type TokenManagerConfig struct {
+	Cache           *cache.RedisCache // Optional; tokens are kept in memory without it
+	EncryptionKey   string            // Encrypts tokens stored in Cache; without it they stay in memory
+	RefreshInterval time.Duration     // How often the background loop checks tokens
+	RefreshBefore   time.Duration     // Refresh tokens expiring within this window
+	HTTPClient      *http.Client
+	AuditLogger     *audit.AuditLogger
+}
+
+// TokenManager obtains, caches and refreshes OAuth2 access tokens
+type TokenManager struct {
+	providers     map[string]ProviderConfig
+	tokens        *cache.TokenCache
+	local         map[string]cache.Token
+	status        map[string]*TokenStatus
+	inflight      map[string]*refreshCall
+	httpClient    *http.Client
+	audit         *audit.AuditLogger
+	interval      time.Duration
+	refreshBefore time.Duration
+	mu            sync.Mutex
+}
+
+// TokenStatus describes the token state of a service
+type TokenStatus struct {
+	Service     string    `json:"service"`
+	HasToken    bool      `json:"has_token"`
+	ExpiresAt   time.Time `json:"expires_at,omitempty"`
+	LastRefresh time.Time `json:"last_refresh,omitempty"`
+	LastError   string    `json:"last_error,omitempty"`
+}
+
+// refreshCall deduplicates concurrent refreshes of the same token
+type refreshCall struct {
+	done  chan struct{}
+	token *cache.Token
+	err   error
+}
+
+// tokenResponse is an OAuth2 token endpoint response
+type tokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	Scope        string `json:"scope"`
+	Error        string `json:"error"`
+	ErrorDesc    string `json:"error_description"`
+}
+
+// NewTokenManager creates a new token manager
+func NewTokenManager(cfg TokenManagerConfig) *TokenManager {
+	if cfg.RefreshInterval == 0 {
+		cfg.RefreshInterval = 30 * time.Minute
+	}
+	if cfg.RefreshBefore == 0 {
+		cfg.RefreshBefore = 5 * time.Minute
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = &http.Client{Timeout: 30 * time.Second}
+	}
+
+	m := &TokenManager{
+		providers:     make(map[string]ProviderConfig),
+		local:         make(map[string]cache.Token),
+		status:        make(map[string]*TokenStatus),
+		inflight:      make(map[string]*refreshCall),
+		httpClient:    cfg.HTTPClient,
+		audit:         cfg.AuditLogger,
+		interval:      cfg.RefreshInterval,
+		refreshBefore: cfg.RefreshBefore,
+	}
+	if cfg.Cache != nil && cfg.EncryptionKey != "" {
+		m.tokens = cache.NewTokenCache(cfg.Cache, cfg.EncryptionKey)
+	}
+
+	return m
+}
+
+// RegisterProvider adds an OAuth2 provider
+func (m *TokenManager) RegisterProvider(provider ProviderConfig) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.providers[provider.Name] = provider
+	if _, ok := m.status[provider.Name]; !ok {
+		m.status[provider.Name] = &TokenStatus{Service: provider.Name}
+	}
+}
+
+// HasProvider reports whether an OAuth2 provider is registered
+func (m *TokenManager) HasProvider(name string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	_, ok := m.providers[name]
+	return ok
+}
+
+// AccessToken returns a valid access token, refreshing it if it is about to expire
+func (m *TokenManager) AccessToken(ctx context.Context, service string) (string, error) {
+	token, err := m.loadToken(service)
+	if err == nil && time.Until(token.ExpiresAt) > m.refreshBefore {
+		return token.AccessToken, nil
+	}
+
+	token, err = m.refresh(ctx, service)
+	if err != nil {
+		return "", err
+	}
+	return token.AccessToken, nil
+}
+
+// ForceRefresh obtains a new access token after the provider rejected one,
+// even if it looks valid. When the stored token is no longer the rejected one,
+// a refresh that finished since the request was sent replaced it; that token
+// is returned instead of refreshing again.
+func (m *TokenManager) ForceRefresh(ctx context.Context, service, rejected string) (string, error) {
+	if current, err := m.loadToken(service); err == nil && current.AccessToken != rejected && time.Now().Before(current.ExpiresAt) {
+		return current.AccessToken, nil
+	}
+
+	token, err := m.refresh(ctx, service)
+	if err != nil {
+		return "", err
+	}
+	return token.AccessToken, nil
+}
+
+// SetToken stores a token obtained elsewhere (e.g. an authorization-code flow)
+func (m *TokenManager) SetToken(service string, token cache.Token) error {
+	return m.storeToken(service, token)
+}
+
+// Status returns the token state of a service
+func (m *TokenManager) Status(service string) TokenStatus {
+	m.mu.Lock()
+	status := TokenStatus{Service: service}
+	if s, ok := m.status[service]; ok {
+		status = *s
+	}
+	m.mu.Unlock()
+
+	if token, err := m.loadToken(service); err == nil {
+		status.HasToken = true
+		status.ExpiresAt = token.ExpiresAt
+	}
+	return status
+}
+
+// Connections returns the state of every token stored for a provider,
+// both the provider-wide token and those of individual tenants or users
+func (m *TokenManager) Connections(provider string) ([]TokenStatus, error) {
+	var keys []string
+	if m.tokens != nil {
+		services, err := m.tokens.Services(provider)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list tokens: %w", err)
+		}
+		keys = services
+	} else {
+		m.mu.Lock()
+		for key := range m.local {
+			keys = append(keys, key)
+		}
+		m.mu.Unlock()
+	}
+
+	statuses := make([]TokenStatus, 0, len(keys))
+	for _, key := range keys {
+		if name, _ := splitTokenKey(key); name != provider {
+			continue
+		}
+		if status := m.Status(key); status.HasToken {
+			statuses = append(statuses, status)
+		}
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Service < statuses[j].Service })
+	return statuses, nil
+}
+
+// Start refreshes tokens in the background until the context is cancelled
+func (m *TokenManager) Start(ctx context.Context) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.refreshExpiring(ctx)
+		}
+	}
+}
+
+// refreshExpiring refreshes tokens that expire before the next check
+func (m *TokenManager) refreshExpiring(ctx context.Context) {
+	m.mu.Lock()
+	services := make([]string, 0, len(m.providers))
+	for name := range m.providers {
+		services = append(services, name)
+	}
+	m.mu.Unlock()
+
+	for _, service := range services {
+		token, err := m.loadToken(service)
+		if err != nil || time.Until(token.ExpiresAt) > m.interval+m.refreshBefore {
+			continue
+		}
+		_, _ = m.refresh(ctx, service)
+	}
+}
+
+// refresh obtains a new token, sharing the result with concurrent callers
+func (m *TokenManager) refresh(ctx context.Context, service string) (*cache.Token, error) {
+	m.mu.Lock()
+	if call, ok := m.inflight[service]; ok {
+		m.mu.Unlock()
+		select {
+		case <-call.done:
+			return call.token, call.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	call := &refreshCall{done: make(chan struct{})}
+	m.inflight[service] = call
+	m.mu.Unlock()
+
+	// Detach from the caller so one cancelled request does not fail the others
+	refreshCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 30*time.Second)
+	call.token, call.err = m.requestToken(refreshCtx, service)
+	cancel()
+
+	m.mu.Lock()
+	delete(m.inflight, service)
+	status, ok := m.status[service]
+	if !ok {
+		status = &TokenStatus{Service: service}
+		m.status[service] = status
+	}
+	if call.err != nil {
+		status.LastError = call.err.Error()
+	} else {
+		status.LastRefresh = time.Now()
+		status.LastError = ""
+	}
+	m.mu.Unlock()
+	close(call.done)
+
+	if m.audit != nil {
+		m.audit.LogTokenRefresh(service, call.err == nil, call.err)
+	}
+
+	return call.token, call.err
+}
+
+// requestToken calls the provider's token endpoint
+func (m *TokenManager) requestToken(ctx context.Context, service string) (*cache.Token, error) {
+	name, tenant := splitTokenKey(service)
+	m.mu.Lock()
+	provider, ok := m.providers[name]
+	m.mu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("no OAuth2 provider registered for %s", name)
+	}
+
+	form := url.Values{}
+	form.Set("client_id", provider.ClientID)
+	form.Set("client_secret", provider.ClientSecret)
+
+	// Prefer the refresh token; fall back to client credentials
+	current, err := m.loadToken(service)
+	if err == nil && current.RefreshToken != "" {
+		form.Set("grant_type", "refresh_token")
+		form.Set("refresh_token", current.RefreshToken)
+	} else if tenant != "" {
+		// Client credentials would return an app token, not the tenant's
+		return nil, fmt.Errorf("%s must be authorized again for %s", name, tenant)
+	} else {
+		form.Set("grant_type", "client_credentials")
+		if len(provider.Scopes) > 0 {
+			form.Set("scope", strings.Join(provider.Scopes, " "))
+		}
+	}
+
+	token, err := m.Exchange(ctx, provider.TokenURL, form)
+	if err != nil {
+		return nil, err
+	}
+
+	// Providers may omit the refresh token when it does not rotate
+	if token.RefreshToken == "" && current != nil {
+		token.RefreshToken = current.RefreshToken
+	}
+
+	if err := m.storeToken(service, *token); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// Exchange posts a token request (any grant type) and parses the response
+func (m *TokenManager) Exchange(ctx context.Context, tokenURL string, form url.Values) (*cache.Token, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := m.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("token request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read token response: %w", err)
+	}
+
+	var tr tokenResponse
+	if err := json.Unmarshal(body, &tr); err != nil {
+		return nil, fmt.Errorf("invalid token response (status %d): %w", resp.StatusCode, err)
+	}
+	if resp.StatusCode != http.StatusOK || tr.AccessToken == "" {
+		if tr.Error != "" {
+			return nil, fmt.Errorf("token request rejected: %s: %s", tr.Error, tr.ErrorDesc)
+		}
+		return nil, fmt.Errorf("token request failed with status %d", resp.StatusCode)
+	}
+
+	tokenType := tr.TokenType
+	if tokenType == "" {
+		tokenType = "Bearer"
+	}
+	expiresIn := time.Duration(tr.ExpiresIn) * time.Second
+	if expiresIn <= 0 {
+		expiresIn = time.Hour
+	}
+
+	return &cache.Token{
+		AccessToken:  tr.AccessToken,
+		RefreshToken: tr.RefreshToken,
+		TokenType:    tokenType,
+		ExpiresAt:    time.Now().Add(expiresIn),
+		Scope:        tr.Scope,
+	}, nil
+}
+
+// loadToken reads a token from Redis or memory
+func (m *TokenManager) loadToken(service string) (*cache.Token, error) {
+	if m.tokens != nil {
+		return m.tokens.GetToken(service)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	token, ok := m.local[service]
+	if !ok {
+		return nil, fmt.Errorf("no token for %s", service)
+	}
+	return &token, nil
+}
+
+// storeToken writes a token to Redis or memory
+func (m *TokenManager) storeToken(service string, token cache.Token) error {
+	if m.tokens != nil {
+		if err := m.tokens.SetToken(service, token); err != nil {
+			return fmt.Errorf("failed to store token: %w", err)
+		}
+		return nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.local[service] = token
+	return nil
+}
@@ -4,8 +4,12 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
+
+	"github.com/redis/go-redis/v9"
 )
 
 // QueryCache provides caching for database query results
@@ -91,6 +95,96 @@ func (qc *QueryCache) InvalidatePattern(ctx context.Context, pattern string) err
 	return fmt.Errorf("pattern invalidation not implemented")
 }
 
+// GetIn retrieves a cached result stored under a namespace. Unlike Get it
+// reports Redis errors, so callers can tell a miss from an outage.
+func (qc *QueryCache) GetIn(ctx context.Context, namespace, query string, dest interface{}) (bool, error) {
+	if qc.redis == nil {
+		return false, fmt.Errorf("redis cache not configured")
+	}
+
+	data, err := qc.redis.Client().Get(ctx, qc.namespacedKey(namespace, query)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get cached result: %w", err)
+	}
+	if err := json.Unmarshal(data, dest); err != nil {
+		return false, fmt.Errorf("failed to unmarshal cached result: %w", err)
+	}
+	return true, nil
+}
+
+// SetIn stores a result under a namespace, e.g. one entity type of one tenant
+func (qc *QueryCache) SetIn(ctx context.Context, namespace, query string, data interface{}, ttl time.Duration) error {
+	if qc.redis == nil {
+		return fmt.Errorf("redis cache not configured")
+	}
+	if ttl <= 0 {
+		ttl = qc.defaultTTL
+	}
+
+	value, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal value: %w", err)
+	}
+	return qc.redis.Client().Set(ctx, qc.namespacedKey(namespace, query), value, ttl).Err()
+}
+
+// Generations returns the current generation of each scope (0 when never
+// bumped). Callers include them in their queries, so bumping a scope
+// orphans every result cached under it without scanning for keys.
+func (qc *QueryCache) Generations(ctx context.Context, scopes ...string) ([]int64, error) {
+	if qc.redis == nil {
+		return nil, fmt.Errorf("redis cache not configured")
+	}
+
+	keys := make([]string, len(scopes))
+	for i, scope := range scopes {
+		keys[i] = qc.generationKey(scope)
+	}
+	values, err := qc.redis.Client().MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cache generations: %w", err)
+	}
+
+	generations := make([]int64, len(values))
+	for i, value := range values {
+		if s, ok := value.(string); ok {
+			fmt.Sscan(s, &generations[i])
+		}
+	}
+	return generations, nil
+}
+
+// Bump invalidates every result cached under the given scopes
+func (qc *QueryCache) Bump(ctx context.Context, scopes ...string) error {
+	if qc.redis == nil {
+		return fmt.Errorf("redis cache not configured")
+	}
+
+	pipe := qc.redis.Client().Pipeline()
+	for _, scope := range scopes {
+		pipe.Incr(ctx, qc.generationKey(scope))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to bump cache generations: %w", err)
+	}
+	return nil
+}
+
+// namespacedKey creates a cache key from a namespace and query string
+func (qc *QueryCache) namespacedKey(namespace, query string) string {
+	hash := sha256.Sum256([]byte(query))
+	return fmt.Sprintf("%s:%s:%s", qc.prefix, namespace, hex.EncodeToString(hash[:]))
+}
+
+// generationKey returns the counter key of a scope. It has no expiry, since
+// resetting it could make orphaned results valid again.
+func (qc *QueryCache) generationKey(scope string) string {
+	return fmt.Sprintf("%s:gen:%s", qc.prefix, scope)
+}
+
 // generateKey creates a cache key from a query string
 func (qc *QueryCache) generateKey(query string) string {
 	// Use SHA256 hash of query as key (deterministic and collision-resistant)
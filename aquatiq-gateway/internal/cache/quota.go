@@ -0,0 +1,125 @@
+package cache
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// takeQuotaScript keeps one sorted set of request timestamps per window and
+// adds the request to all of them only if every window has room. It uses the
+// Redis clock so replicas with skewed clocks share the same windows.
+//
+// KEYS: window keys; ARGV: member, then limit and window (ms) per key.
+// Returns: allowed (0/1), retry after (ms), exceeded index (0-based, -1 if
+// none), then the remaining budget of each window.
+var takeQuotaScript = redis.NewScript(`
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+local wait, exceeded = 0, -1
+local remaining = {}
+
+for i = 1, #KEYS do
+  local limit = tonumber(ARGV[2 * i])
+  local window = tonumber(ARGV[2 * i + 1])
+  redis.call('ZREMRANGEBYSCORE', KEYS[i], '-inf', now - window)
+  local count = redis.call('ZCARD', KEYS[i])
+  remaining[i] = limit - count
+  if count >= limit then
+    local oldest = redis.call('ZRANGE', KEYS[i], count - limit, count - limit, 'WITHSCORES')
+    local w = tonumber(oldest[2]) + window - now
+    if w < 1 then w = 1 end
+    if w > wait then
+      wait, exceeded = w, i - 1
+    end
+  end
+end
+
+if exceeded >= 0 then
+  return {0, wait, exceeded, unpack(remaining)}
+end
+
+for i = 1, #KEYS do
+  redis.call('ZADD', KEYS[i], now, ARGV[1])
+  redis.call('PEXPIRE', KEYS[i], tonumber(ARGV[2 * i + 1]))
+  remaining[i] = remaining[i] - 1
+end
+return {1, 0, -1, unpack(remaining)}
+`)
+
+// QuotaWindow is one sliding window a request is counted against
+type QuotaWindow struct {
+	Key    string
+	Limit  int
+	Window time.Duration
+}
+
+// QuotaResult is the outcome of taking a request from a set of windows
+type QuotaResult struct {
+	Allowed    bool
+	RetryAfter time.Duration // When denied: how long until every window has room
+	Exceeded   int           // When denied: index of the window that is full, -1 otherwise
+	Remaining  []int         // Requests left in each window after this request
+}
+
+// QuotaStore shares outbound quota windows between gateway replicas through Redis
+type QuotaStore struct {
+	cache *RedisCache
+}
+
+// NewQuotaStore creates a new Redis-backed quota store
+func NewQuotaStore(cache *RedisCache) *QuotaStore {
+	return &QuotaStore{cache: cache}
+}
+
+// Take counts a request against all windows, or against none of them when one is full
+func (s *QuotaStore) Take(ctx context.Context, windows []QuotaWindow) (QuotaResult, error) {
+	if len(windows) == 0 {
+		return QuotaResult{Allowed: true, Exceeded: -1}, nil
+	}
+
+	member, err := quotaMember()
+	if err != nil {
+		return QuotaResult{}, err
+	}
+
+	keys := make([]string, len(windows))
+	args := make([]interface{}, 0, 1+2*len(windows))
+	args = append(args, member)
+	for i, w := range windows {
+		keys[i] = w.Key
+		args = append(args, w.Limit, w.Window.Milliseconds())
+	}
+
+	values, err := takeQuotaScript.Run(ctx, s.cache.client, keys, args...).Int64Slice()
+	if err != nil {
+		return QuotaResult{}, fmt.Errorf("failed to take quota: %w", err)
+	}
+	if len(values) != 3+len(windows) {
+		return QuotaResult{}, fmt.Errorf("unexpected quota script result")
+	}
+
+	result := QuotaResult{
+		Allowed:    values[0] == 1,
+		RetryAfter: time.Duration(values[1]) * time.Millisecond,
+		Exceeded:   int(values[2]),
+		Remaining:  make([]int, len(windows)),
+	}
+	for i := range windows {
+		result.Remaining[i] = int(values[3+i])
+	}
+	return result, nil
+}
+
+// quotaMember returns a unique sorted set member for one request
+func quotaMember() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate quota member: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -2,9 +2,14 @@ package cache
 
 import (
 	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aquatiq/integration-gateway/internal/config"
@@ -124,6 +129,17 @@ func (r *RedisCache) Keys(pattern string) ([]string, error) {
 	return r.client.Keys(r.ctx, pattern).Result()
 }
 
+// Scan returns all keys matching pattern. Unlike Keys it walks the keyspace
+// with a cursor, so Redis keeps serving other clients meanwhile.
+func (r *RedisCache) Scan(pattern string) ([]string, error) {
+	var keys []string
+	iter := r.client.Scan(r.ctx, 0, pattern, 100).Iterator()
+	for iter.Next(r.ctx) {
+		keys = append(keys, iter.Val())
+	}
+	return keys, iter.Err()
+}
+
 // FlushDB clears the current database
 func (r *RedisCache) FlushDB() error {
 	return r.client.FlushDB(r.ctx).Err()
@@ -149,16 +165,27 @@ func (r *RedisCache) Client() *redis.Client {
 	return r.client
 }
 
-// TokenCache provides methods for managing OAuth2 tokens
+// refreshTokenTTL bounds how long a refreshable token is kept; SuperOffice and
+// Visma.net refresh tokens expire after 90 days
+const refreshTokenTTL = 90 * 24 * time.Hour
+
+// TokenCache stores OAuth2 tokens in Redis encrypted with AES-256-GCM
 type TokenCache struct {
 	cache  *RedisCache
+	aead   cipher.AEAD
 	prefix string
 }
 
-// NewTokenCache creates a new token cache
-func NewTokenCache(cache *RedisCache) *TokenCache {
+// NewTokenCache creates a token cache encrypting tokens with a key derived
+// from encryptionKey
+func NewTokenCache(cache *RedisCache, encryptionKey string) *TokenCache {
+	sum := sha256.Sum256([]byte(encryptionKey))
+	block, _ := aes.NewCipher(sum[:]) // A 32-byte key is always valid
+	aead, _ := cipher.NewGCM(block)
+
 	return &TokenCache{
 		cache:  cache,
+		aead:   aead,
 		prefix: "token:",
 	}
 }
@@ -173,20 +200,29 @@ type Token struct {
 }
 
 // SetToken stores an OAuth2 token
+// Tokens with a refresh token are kept after the access token expires so they can be
+// refreshed, but no longer than a refresh token lives (each refresh stores them again)
 func (t *TokenCache) SetToken(service string, token Token) error {
 	key := t.prefix + service
 	expiration := time.Until(token.ExpiresAt)
-	return t.cache.Set(key, token, expiration)
+	if token.RefreshToken != "" {
+		expiration = max(expiration, refreshTokenTTL)
+	}
+	sealed, err := t.seal(key, token)
+	if err != nil {
+		return err
+	}
+	return t.cache.Set(key, sealed, expiration)
 }
 
 // GetToken retrieves an OAuth2 token
 func (t *TokenCache) GetToken(service string) (*Token, error) {
 	key := t.prefix + service
-	var token Token
-	if err := t.cache.Get(key, &token); err != nil {
+	var sealed []byte
+	if err := t.cache.Get(key, &sealed); err != nil {
 		return nil, err
 	}
-	return &token, nil
+	return t.open(key, sealed)
 }
 
 // DeleteToken removes an OAuth2 token
@@ -195,6 +231,20 @@ func (t *TokenCache) DeleteToken(service string) error {
 	return t.cache.Delete(key)
 }
 
+// Services returns the services with a stored token whose name starts with prefix
+func (t *TokenCache) Services(prefix string) ([]string, error) {
+	keys, err := t.cache.Scan(t.prefix + prefix + "*")
+	if err != nil {
+		return nil, err
+	}
+
+	services := make([]string, len(keys))
+	for i, key := range keys {
+		services[i] = strings.TrimPrefix(key, t.prefix)
+	}
+	return services, nil
+}
+
 // IsTokenValid checks if a token exists and is not expired
 func (t *TokenCache) IsTokenValid(service string) (bool, error) {
 	token, err := t.GetToken(service)
@@ -203,3 +253,35 @@ func (t *TokenCache) IsTokenValid(service string) (bool, error) {
 	}
 	return time.Now().Before(token.ExpiresAt), nil
 }
+
+// seal encrypts a token; the key is authenticated with it, so a token cannot
+// be moved to another service or tenant
+func (t *TokenCache) seal(key string, token Token) ([]byte, error) {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal token: %w", err)
+	}
+	nonce := make([]byte, t.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	return t.aead.Seal(nonce, nonce, data, []byte(key)), nil
+}
+
+// open decrypts a token stored by seal
+func (t *TokenCache) open(key string, sealed []byte) (*Token, error) {
+	if len(sealed) < t.aead.NonceSize() {
+		return nil, fmt.Errorf("failed to decrypt token: value too short")
+	}
+	nonce, ciphertext := sealed[:t.aead.NonceSize()], sealed[t.aead.NonceSize():]
+	data, err := t.aead.Open(nil, nonce, ciphertext, []byte(key))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt token: %w", err)
+	}
+
+	var token Token
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal token: %w", err)
+	}
+	return &token, nil
+}
@@ -0,0 +1,676 @@
+package customersync
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/aquatiq/integration-gateway/internal/audit"
+	"github.com/aquatiq/integration-gateway/internal/config"
+	"github.com/aquatiq/integration-gateway/internal/integrations"
+	"github.com/aquatiq/integration-gateway/internal/integrations/superoffice"
+	"github.com/aquatiq/integration-gateway/internal/integrations/visma"
+	"github.com/aquatiq/integration-gateway/pkg/httpclient"
+)
+
+// Sync directions
+const (
+	SyncBidirectional = "bidirectional"
+	SyncToVisma       = "superoffice_to_visma"
+	SyncToSuperOffice = "visma_to_superoffice"
+)
+
+// Conflict winners
+const (
+	WinnerSuperOffice = "superoffice"
+	WinnerVisma       = "visma"
+	WinnerNewest      = "newest" // The side changed most recently
+	WinnerManual      = "manual" // Neither; the conflict is reported and left alone
+)
+
+// Run statuses
+const (
+	StatusRunning   = "running"
+	StatusSucceeded = "succeeded"
+	StatusPartial   = "partial" // Finished, but some customers failed
+	StatusFailed    = "failed"
+)
+
+// Item actions
+const (
+	ActionCreatedInVisma       = "created_in_visma"
+	ActionUpdatedInVisma       = "updated_in_visma"
+	ActionCreatedInSuperOffice = "created_in_superoffice"
+	ActionUpdatedInSuperOffice = "updated_in_superoffice"
+	ActionSkipped              = "skipped"
+	ActionConflict             = "conflict"
+	ActionFailed               = "failed"
+)
+
+// Cursor names
+const (
+	superOfficeCursor = "superoffice_contacts"
+	vismaCursor       = "visma_customers"
+)
+
+// pageSize is the number of changed records fetched per request
+const pageSize = 200
+
+// Config holds customer sync engine configuration
+type Config struct {
+	SuperOffice *superoffice.Client
+	Visma       *visma.Client
+	Store       *Store
+	Sync        config.SyncConfig
+	Company     string // Visma company to sync with
+	AuditLogger *audit.AuditLogger
+}
+
+// RunOptions controls a single sync run
+type RunOptions struct {
+	Full    bool   // Ignore the cursors and compare every customer
+	DryRun  bool   // Report what would change without writing anything
+	Trigger string // What started the run, e.g. schedule or grpc
+}
+
+// ItemResult is the outcome for one customer
+type ItemResult struct {
+	SuperOfficeID int    `json:"superoffice_contact_id,omitempty"`
+	VismaNumber   string `json:"visma_customer_number,omitempty"`
+	Action        string `json:"action"`
+	Winner        string `json:"winner,omitempty"` // Set when a conflict was resolved
+	Message       string `json:"message,omitempty"`
+}
+
+// RunResult summarizes a sync run. Unchanged customers are counted but not listed.
+type RunResult struct {
+	ID                   int64        `json:"id"`
+	Trigger              string       `json:"trigger"`
+	DryRun               bool         `json:"dry_run"`
+	Full                 bool         `json:"full"`
+	Status               string       `json:"status"`
+	StartedAt            time.Time    `json:"started_at"`
+	FinishedAt           time.Time    `json:"finished_at"`
+	CreatedInVisma       int          `json:"created_in_visma"`
+	UpdatedInVisma       int          `json:"updated_in_visma"`
+	CreatedInSuperOffice int          `json:"created_in_superoffice"`
+	UpdatedInSuperOffice int          `json:"updated_in_superoffice"`
+	Unchanged            int          `json:"unchanged"`
+	Skipped              int          `json:"skipped"`
+	Conflicts            int          `json:"conflicts"`
+	Failed               int          `json:"failed"`
+	Error                string       `json:"error,omitempty"`
+	Items                []ItemResult `json:"items,omitempty"`
+}
+
+// Engine synchronizes SuperOffice contacts with Visma customers. Each run
+// fetches what changed on either side since the stored cursors, pairs
+// records through the mapping table and copies the mapped fields across.
+// Per-side hashes of the mapped fields detect which side changed, so the
+// gateway's own writes are not mistaken for user edits.
+type Engine struct {
+	superOffice *superoffice.Client
+	visma       *visma.Client
+	store       *Store
+	mappings    []mapping
+	company     string
+	direction   string
+	winner      string
+	interval    time.Duration
+	overlap     time.Duration
+	audit       *audit.AuditLogger
+	notify      chan struct{}
+
+	mu      sync.Mutex
+	lastRun *RunResult
+}
+
+// New creates a new customer sync engine
+func New(cfg Config) (*Engine, error) {
+	if cfg.SuperOffice == nil || cfg.Visma == nil {
+		return nil, fmt.Errorf("superoffice and visma clients are required")
+	}
+	if cfg.Store == nil {
+		return nil, fmt.Errorf("sync store is required")
+	}
+	if cfg.Company == "" {
+		return nil, fmt.Errorf("visma company is required")
+	}
+
+	switch cfg.Sync.Direction {
+	case "":
+		cfg.Sync.Direction = SyncBidirectional
+	case SyncBidirectional, SyncToVisma, SyncToSuperOffice:
+	default:
+		return nil, fmt.Errorf("invalid sync direction %q", cfg.Sync.Direction)
+	}
+	switch cfg.Sync.ConflictWinner {
+	case "":
+		cfg.Sync.ConflictWinner = WinnerSuperOffice
+	case WinnerSuperOffice, WinnerVisma, WinnerNewest, WinnerManual:
+	default:
+		return nil, fmt.Errorf("invalid conflict winner %q", cfg.Sync.ConflictWinner)
+	}
+	if cfg.Sync.Interval <= 0 {
+		cfg.Sync.Interval = 15 * time.Minute
+	}
+
+	mappings, err := compileMappings(cfg.Sync.Mappings)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Engine{
+		superOffice: cfg.SuperOffice,
+		visma:       cfg.Visma,
+		store:       cfg.Store,
+		mappings:    mappings,
+		company:     cfg.Company,
+		direction:   cfg.Sync.Direction,
+		winner:      cfg.Sync.ConflictWinner,
+		interval:    cfg.Sync.Interval,
+		overlap:     cfg.Sync.Overlap,
+		audit:       cfg.AuditLogger,
+		notify:      make(chan struct{}, 1),
+	}, nil
+}
+
+// Start runs incremental syncs on the configured interval, and soon after
+// Notify is called, until the context is cancelled
+func (e *Engine) Start(ctx context.Context) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// Failures are recorded on the run and in the audit log
+			_, _ = e.Run(ctx, RunOptions{Trigger: "schedule"})
+		case <-e.notify:
+			_, _ = e.Run(ctx, RunOptions{Trigger: "webhook"})
+		}
+	}
+}
+
+// Notify asks for an incremental run because a side reported a change.
+// It does not block; notifications arriving during a run are coalesced
+// into one follow-up run.
+func (e *Engine) Notify() {
+	select {
+	case e.notify <- struct{}{}:
+	default:
+	}
+}
+
+// LastRun returns the most recent run of this instance, or nil
+func (e *Engine) LastRun() *RunResult {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.lastRun
+}
+
+// Store returns the engine's sync store
+func (e *Engine) Store() *Store {
+	return e.store
+}
+
+// Run performs one sync run. Only one run may be active across all gateway
+// instances; others fail with ErrRunInProgress.
+func (e *Engine) Run(ctx context.Context, opts RunOptions) (*RunResult, error) {
+	unlock, err := e.store.lock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	run := &RunResult{
+		Trigger:   opts.Trigger,
+		DryRun:    opts.DryRun,
+		Full:      opts.Full,
+		Status:    StatusRunning,
+		StartedAt: time.Now().UTC(),
+	}
+	if err := e.store.StartRun(ctx, run); err != nil {
+		return nil, err
+	}
+
+	// Failed customers hold back the cursors and are retried by the next run,
+	// so their writes must not also be replayed as dead letters. Reads skip
+	// the response cache, since conflict checks need the providers' current data.
+	runErr := e.run(integrations.WithoutCache(httpclient.WithoutDeadLetter(ctx)), run, opts)
+
+	run.FinishedAt = time.Now().UTC()
+	switch {
+	case runErr != nil:
+		run.Status = StatusFailed
+		run.Error = runErr.Error()
+	case run.Failed > 0:
+		run.Status = StatusPartial
+	default:
+		run.Status = StatusSucceeded
+	}
+
+	// Record the outcome even if the run was cancelled
+	if err := e.store.FinishRun(context.WithoutCancel(ctx), run); err != nil && runErr == nil {
+		runErr = err
+	}
+
+	e.mu.Lock()
+	e.lastRun = run
+	e.mu.Unlock()
+
+	e.auditRun(run)
+	return run, runErr
+}
+
+// run fetches changes from both sides and reconciles them
+func (e *Engine) run(ctx context.Context, run *RunResult, opts RunOptions) error {
+	var contacts []superoffice.Contact
+	var customers []visma.Customer
+	var soSince, vSince time.Time
+
+	if e.direction != SyncToSuperOffice {
+		var err error
+		if soSince, err = e.since(ctx, superOfficeCursor, opts.Full); err != nil {
+			return err
+		}
+		if contacts, err = e.changedContacts(ctx, soSince); err != nil {
+			return fmt.Errorf("failed to fetch superoffice contacts: %w", err)
+		}
+	}
+	if e.direction != SyncToVisma {
+		var err error
+		if vSince, err = e.since(ctx, vismaCursor, opts.Full); err != nil {
+			return err
+		}
+		if customers, err = e.changedCustomers(ctx, vSince); err != nil {
+			return fmt.Errorf("failed to fetch visma customers: %w", err)
+		}
+	}
+
+	// Both lists are fetched before anything is written, so records created
+	// by this run are only seen (as unchanged) by the next one
+	seen := make(map[string]bool)
+	soCursor := newCursor()
+	for i := range contacts {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		contact := &contacts[i]
+		item, number := e.syncContact(ctx, contact, opts.DryRun)
+		if number != "" {
+			seen[number] = true
+		}
+		e.record(run, item)
+		soCursor.advance(contact.UpdatedDate.Time, item.Action != ActionFailed)
+	}
+
+	vCursor := newCursor()
+	for i := range customers {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		customer := &customers[i]
+		if seen[customer.Number] {
+			vCursor.advance(customer.LastModifiedDateTime.Time, true)
+			continue
+		}
+		item := e.syncCustomer(ctx, customer, opts.DryRun)
+		e.record(run, item)
+		vCursor.advance(customer.LastModifiedDateTime.Time, item.Action != ActionFailed)
+	}
+
+	if opts.DryRun {
+		return nil
+	}
+	if err := soCursor.save(ctx, e.store, superOfficeCursor); err != nil {
+		return err
+	}
+	return vCursor.save(ctx, e.store, vismaCursor)
+}
+
+// since returns the time to fetch changes from
+func (e *Engine) since(ctx context.Context, cursor string, full bool) (time.Time, error) {
+	if full {
+		return time.Time{}, nil
+	}
+	position, err := e.store.Cursor(ctx, cursor)
+	if err != nil || position.IsZero() {
+		return position, err
+	}
+	return position.Add(-e.overlap), nil
+}
+
+// changedContacts returns SuperOffice contacts changed after since, oldest first
+func (e *Engine) changedContacts(ctx context.Context, since time.Time) ([]superoffice.Contact, error) {
+	q := superoffice.Query{OrderBy: "updatedDate", Top: pageSize}
+	if !since.IsZero() {
+		q.Filter = superoffice.After("updatedDate", since)
+	}
+
+	var contacts []superoffice.Contact
+	err := e.superOffice.Contacts.ListAll(ctx, q, func(page []superoffice.Contact) error {
+		contacts = append(contacts, page...)
+		return nil
+	})
+	sort.SliceStable(contacts, func(i, j int) bool {
+		return contacts[i].UpdatedDate.Before(contacts[j].UpdatedDate.Time)
+	})
+	return contacts, err
+}
+
+// changedCustomers returns Visma customers changed after since, oldest first
+func (e *Engine) changedCustomers(ctx context.Context, since time.Time) ([]visma.Customer, error) {
+	var customers []visma.Customer
+	opts := visma.ListOptions{PageSize: pageSize, ModifiedSince: since}
+	err := e.visma.Customers.ListAll(ctx, e.company, opts, func(page []visma.Customer) error {
+		customers = append(customers, page...)
+		return nil
+	})
+	sort.SliceStable(customers, func(i, j int) bool {
+		return customers[i].LastModifiedDateTime.Before(customers[j].LastModifiedDateTime.Time)
+	})
+	return customers, err
+}
+
+// syncContact reconciles a changed SuperOffice contact; it also returns the
+// number of the Visma customer it is paired with
+func (e *Engine) syncContact(ctx context.Context, contact *superoffice.Contact, dryRun bool) (ItemResult, string) {
+	item := ItemResult{SuperOfficeID: contact.ContactID}
+	if contact.Deleted {
+		item.Action, item.Message = ActionSkipped, "contact is deleted"
+		return item, ""
+	}
+
+	m, err := e.store.MappingBySuperOffice(ctx, e.company, contact.ContactID)
+	if err != nil {
+		return failed(item, err), ""
+	}
+
+	if m == nil {
+		customer := &visma.Customer{Status: "Active"}
+		toVisma(e.mappings, contact, customer)
+		item.Action = ActionCreatedInVisma
+		if dryRun {
+			return item, ""
+		}
+		created, err := e.visma.Customers.Create(ctx, e.company, customer)
+		if err != nil {
+			return failed(item, err), ""
+		}
+		item.VismaNumber = created.Number
+		if err := e.saveMapping(ctx, contact, created); err != nil {
+			return failed(item, err), created.Number
+		}
+		return item, created.Number
+	}
+
+	item.VismaNumber = m.VismaNumber
+	customer, err := e.visma.Customers.Get(ctx, e.company, m.VismaNumber)
+	if err != nil {
+		return failed(item, err), m.VismaNumber
+	}
+	return e.reconcile(ctx, m, contact, customer, dryRun), m.VismaNumber
+}
+
+// syncCustomer reconciles a changed Visma customer
+func (e *Engine) syncCustomer(ctx context.Context, customer *visma.Customer, dryRun bool) ItemResult {
+	item := ItemResult{VismaNumber: customer.Number}
+
+	m, err := e.store.MappingByVisma(ctx, e.company, customer.Number)
+	if err != nil {
+		return failed(item, err)
+	}
+
+	if m == nil {
+		contact := &superoffice.Contact{}
+		toSuperOffice(e.mappings, customer, contact)
+		item.Action = ActionCreatedInSuperOffice
+		if dryRun {
+			return item
+		}
+		created, err := e.superOffice.Contacts.Create(ctx, contact)
+		if err != nil {
+			return failed(item, err)
+		}
+		item.SuperOfficeID = created.ContactID
+		if err := e.saveMapping(ctx, created, customer); err != nil {
+			return failed(item, err)
+		}
+		return item
+	}
+
+	item.SuperOfficeID = m.SuperOfficeID
+	contact, err := e.superOffice.Contacts.Get(ctx, m.SuperOfficeID)
+	if err != nil {
+		return failed(item, err)
+	}
+	if contact.Deleted {
+		item.Action, item.Message = ActionSkipped, "contact is deleted"
+		return item
+	}
+	return e.reconcile(ctx, m, contact, customer, dryRun)
+}
+
+// reconcile brings a mapped pair in line, resolving conflicts by the configured winner
+func (e *Engine) reconcile(ctx context.Context, m *Mapping, contact *superoffice.Contact, customer *visma.Customer, dryRun bool) ItemResult {
+	item := ItemResult{SuperOfficeID: contact.ContactID, VismaNumber: customer.Number}
+
+	// Changes on a side that is not synced from are accepted as they are
+	soHash, vHash := contactHash(e.mappings, contact), customerHash(e.mappings, customer)
+	soChanged := soHash != m.SuperOfficeHash && e.direction != SyncToSuperOffice
+	vChanged := vHash != m.VismaHash && e.direction != SyncToVisma
+
+	var push string
+	switch {
+	case soChanged && vChanged:
+		item.Winner = e.resolve(contact, customer)
+		if item.Winner == WinnerManual {
+			item.Action, item.Message = ActionConflict, "changed in both systems"
+			return item
+		}
+		push = item.Winner
+	case soChanged:
+		push = WinnerSuperOffice
+	case vChanged:
+		push = WinnerVisma
+	default:
+		if !dryRun && (soHash != m.SuperOfficeHash || vHash != m.VismaHash) {
+			if err := e.saveMapping(ctx, contact, customer); err != nil {
+				return failed(item, err)
+			}
+		}
+		return item
+	}
+
+	if push == WinnerSuperOffice {
+		item.Action = ActionUpdatedInVisma
+		if dryRun {
+			return item
+		}
+		updated := *customer
+		toVisma(e.mappings, contact, &updated)
+		stored, err := e.visma.Customers.Update(ctx, e.company, customer.Number, &updated)
+		if err != nil {
+			return failed(item, err)
+		}
+		customer = stored
+	} else {
+		item.Action = ActionUpdatedInSuperOffice
+		if dryRun {
+			return item
+		}
+		updated := *contact
+		toSuperOffice(e.mappings, customer, &updated)
+		stored, err := e.superOffice.Contacts.Update(ctx, contact.ContactID, &updated)
+		if err != nil {
+			return failed(item, err)
+		}
+		contact = stored
+	}
+
+	if err := e.saveMapping(ctx, contact, customer); err != nil {
+		return failed(item, err)
+	}
+	return item
+}
+
+// resolve picks the side whose values win a conflict
+func (e *Engine) resolve(contact *superoffice.Contact, customer *visma.Customer) string {
+	if e.winner != WinnerNewest {
+		return e.winner
+	}
+	if customer.LastModifiedDateTime.After(contact.UpdatedDate.Time) {
+		return WinnerVisma
+	}
+	return WinnerSuperOffice
+}
+
+// saveMapping stores a pair with the hashes of its current values
+func (e *Engine) saveMapping(ctx context.Context, contact *superoffice.Contact, customer *visma.Customer) error {
+	return e.store.SaveMapping(ctx, Mapping{
+		SuperOfficeID:   contact.ContactID,
+		VismaCompany:    e.company,
+		VismaNumber:     customer.Number,
+		SuperOfficeHash: contactHash(e.mappings, contact),
+		VismaHash:       customerHash(e.mappings, customer),
+		SyncedAt:        time.Now().UTC(),
+	})
+}
+
+// record adds an item outcome to the run
+func (e *Engine) record(run *RunResult, item ItemResult) {
+	if item.Winner != "" {
+		run.Conflicts++
+		e.auditConflict(item)
+	}
+
+	switch item.Action {
+	case "":
+		run.Unchanged++
+		return
+	case ActionCreatedInVisma:
+		run.CreatedInVisma++
+	case ActionUpdatedInVisma:
+		run.UpdatedInVisma++
+	case ActionCreatedInSuperOffice:
+		run.CreatedInSuperOffice++
+	case ActionUpdatedInSuperOffice:
+		run.UpdatedInSuperOffice++
+	case ActionSkipped, ActionConflict:
+		run.Skipped++
+	case ActionFailed:
+		run.Failed++
+	}
+	run.Items = append(run.Items, item)
+}
+
+// auditRun records the outcome of a run
+func (e *Engine) auditRun(run *RunResult) {
+	if e.audit == nil {
+		return
+	}
+
+	e.audit.LogEvent(audit.AuditEvent{
+		Timestamp: run.FinishedAt,
+		Action:    "customer_sync_run",
+		Actor:     "gateway",
+		Resource:  "customers",
+		Success:   run.Status != StatusFailed,
+		Error:     run.Error,
+		Details: map[string]string{
+			"run_id":                 strconv.FormatInt(run.ID, 10),
+			"trigger":                run.Trigger,
+			"status":                 run.Status,
+			"dry_run":                strconv.FormatBool(run.DryRun),
+			"full":                   strconv.FormatBool(run.Full),
+			"created_in_visma":       strconv.Itoa(run.CreatedInVisma),
+			"updated_in_visma":       strconv.Itoa(run.UpdatedInVisma),
+			"created_in_superoffice": strconv.Itoa(run.CreatedInSuperOffice),
+			"updated_in_superoffice": strconv.Itoa(run.UpdatedInSuperOffice),
+			"unchanged":              strconv.Itoa(run.Unchanged),
+			"skipped":                strconv.Itoa(run.Skipped),
+			"conflicts":              strconv.Itoa(run.Conflicts),
+			"failed":                 strconv.Itoa(run.Failed),
+		},
+		Duration: run.FinishedAt.Sub(run.StartedAt),
+	})
+}
+
+// auditConflict records a customer changed in both systems
+func (e *Engine) auditConflict(item ItemResult) {
+	if e.audit == nil {
+		return
+	}
+
+	e.audit.LogEvent(audit.AuditEvent{
+		Timestamp: time.Now(),
+		Action:    "customer_sync_conflict",
+		Actor:     "gateway",
+		Resource:  "customers",
+		Success:   item.Action != ActionFailed,
+		Error:     errorMessage(item),
+		Details: map[string]string{
+			"superoffice_contact_id": strconv.Itoa(item.SuperOfficeID),
+			"visma_customer_number":  item.VismaNumber,
+			"winner":                 item.Winner,
+			"action":                 item.Action,
+		},
+	})
+}
+
+// Helper functions
+
+// failed marks an item as failed
+func failed(item ItemResult, err error) ItemResult {
+	item.Action = ActionFailed
+	item.Message = err.Error()
+	return item
+}
+
+// errorMessage returns the message of a failed item
+func errorMessage(item ItemResult) string {
+	if item.Action == ActionFailed {
+		return item.Message
+	}
+	return ""
+}
+
+// cursor tracks how far a side was synced. It only moves past records that
+// synced, so a failed record is fetched again by the next run.
+type cursor struct {
+	position time.Time
+	blocked  bool
+}
+
+// newCursor creates a cursor that has not moved
+func newCursor() *cursor {
+	return &cursor{}
+}
+
+// advance moves the cursor to a processed record's timestamp
+func (c *cursor) advance(t time.Time, ok bool) {
+	if c.blocked {
+		return
+	}
+	if !ok {
+		c.blocked = true
+		return
+	}
+	if t.After(c.position) {
+		c.position = t
+	}
+}
+
+// save stores the cursor if it moved
+func (c *cursor) save(ctx context.Context, store *Store, name string) error {
+	if c.position.IsZero() {
+		return nil
+	}
+	return store.SetCursor(ctx, name, c.position)
+}
@@ -0,0 +1,242 @@
+package customersync
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/aquatiq/integration-gateway/internal/config"
+	"github.com/aquatiq/integration-gateway/internal/integrations/superoffice"
+	"github.com/aquatiq/integration-gateway/internal/integrations/visma"
+)
+
+// Mapping directions
+const (
+	DirectionBoth          = "both"
+	DirectionToVisma       = "to_visma"
+	DirectionToSuperOffice = "to_superoffice"
+)
+
+// field reads and writes one value of an entity
+type field[T any] struct {
+	get func(*T) string
+	set func(*T, string)
+}
+
+// superOfficeFields are the contact fields that can be mapped
+var superOfficeFields = map[string]field[superoffice.Contact]{
+	"name":       {func(c *superoffice.Contact) string { return c.Name }, func(c *superoffice.Contact, v string) { c.Name = v }},
+	"department": {func(c *superoffice.Contact) string { return c.Department }, func(c *superoffice.Contact, v string) { c.Department = v }},
+	"orgnr":      {func(c *superoffice.Contact) string { return c.OrgNr }, func(c *superoffice.Contact, v string) { c.OrgNr = v }},
+	"number":     {func(c *superoffice.Contact) string { return c.Number }, func(c *superoffice.Contact, v string) { c.Number = v }},
+	"phone":      {func(c *superoffice.Contact) string { return first(c.Phones) }, func(c *superoffice.Contact, v string) { c.Phones = setFirst(c.Phones, v) }},
+	"email":      {func(c *superoffice.Contact) string { return first(c.Emails) }, func(c *superoffice.Contact, v string) { c.Emails = setFirst(c.Emails, v) }},
+	"url":        {func(c *superoffice.Contact) string { return first(c.Urls) }, func(c *superoffice.Contact, v string) { c.Urls = setFirst(c.Urls, v) }},
+
+	"postal.address1": addressField(func(a *superoffice.ContactAddress) *superoffice.Address { return &a.Postal }, func(a *superoffice.Address) *string { return &a.Address1 }),
+	"postal.address2": addressField(func(a *superoffice.ContactAddress) *superoffice.Address { return &a.Postal }, func(a *superoffice.Address) *string { return &a.Address2 }),
+	"postal.zipcode":  addressField(func(a *superoffice.ContactAddress) *superoffice.Address { return &a.Postal }, func(a *superoffice.Address) *string { return &a.Zipcode }),
+	"postal.city":     addressField(func(a *superoffice.ContactAddress) *superoffice.Address { return &a.Postal }, func(a *superoffice.Address) *string { return &a.City }),
+	"street.address1": addressField(func(a *superoffice.ContactAddress) *superoffice.Address { return &a.Street }, func(a *superoffice.Address) *string { return &a.Address1 }),
+	"street.address2": addressField(func(a *superoffice.ContactAddress) *superoffice.Address { return &a.Street }, func(a *superoffice.Address) *string { return &a.Address2 }),
+	"street.zipcode":  addressField(func(a *superoffice.ContactAddress) *superoffice.Address { return &a.Street }, func(a *superoffice.Address) *string { return &a.Zipcode }),
+	"street.city":     addressField(func(a *superoffice.ContactAddress) *superoffice.Address { return &a.Street }, func(a *superoffice.Address) *string { return &a.City }),
+}
+
+// vismaFields are the customer fields that can be mapped. The customer
+// number is the mapping key and cannot be changed, so it is not listed.
+var vismaFields = map[string]field[visma.Customer]{
+	"name":              {func(c *visma.Customer) string { return c.Name }, func(c *visma.Customer, v string) { c.Name = v }},
+	"corporateid":       {func(c *visma.Customer) string { return c.CorporateID }, func(c *visma.Customer, v string) { c.CorporateID = v }},
+	"vatregistrationid": {func(c *visma.Customer) string { return c.VatRegistrationID }, func(c *visma.Customer, v string) { c.VatRegistrationID = v }},
+	"currencyid":        {func(c *visma.Customer) string { return c.CurrencyID }, func(c *visma.Customer, v string) { c.CurrencyID = v }},
+
+	"address.line1":      vismaAddressField(func(a *visma.Address) *string { return &a.AddressLine1 }),
+	"address.line2":      vismaAddressField(func(a *visma.Address) *string { return &a.AddressLine2 }),
+	"address.postalcode": vismaAddressField(func(a *visma.Address) *string { return &a.PostalCode }),
+	"address.city":       vismaAddressField(func(a *visma.Address) *string { return &a.City }),
+	"address.country": {
+		get: func(c *visma.Customer) string {
+			if c.MainAddress == nil || c.MainAddress.Country == nil {
+				return ""
+			}
+			return c.MainAddress.Country.ID
+		},
+		set: func(c *visma.Customer, v string) {
+			if c.MainAddress == nil {
+				c.MainAddress = &visma.Address{}
+			}
+			c.MainAddress.Country = &visma.Country{ID: v}
+		},
+	},
+
+	"contact.name":  vismaContactField(func(ct *visma.Contact) *string { return &ct.Name }),
+	"contact.email": vismaContactField(func(ct *visma.Contact) *string { return &ct.Email }),
+	"contact.phone": vismaContactField(func(ct *visma.Contact) *string { return &ct.Phone1 }),
+}
+
+// DefaultMappings are used when no field mappings are configured
+var DefaultMappings = []config.SyncFieldMapping{
+	{SuperOffice: "name", Visma: "name"},
+	{SuperOffice: "orgnr", Visma: "corporateid"},
+	{SuperOffice: "email", Visma: "contact.email"},
+	{SuperOffice: "phone", Visma: "contact.phone"},
+	{SuperOffice: "postal.address1", Visma: "address.line1"},
+	{SuperOffice: "postal.address2", Visma: "address.line2"},
+	{SuperOffice: "postal.zipcode", Visma: "address.postalcode"},
+	{SuperOffice: "postal.city", Visma: "address.city"},
+}
+
+// mapping is a validated field mapping
+type mapping struct {
+	superOffice string
+	visma       string
+	direction   string
+	so          field[superoffice.Contact]
+	v           field[visma.Customer]
+}
+
+// compileMappings validates the configured field mappings
+func compileMappings(configured []config.SyncFieldMapping) ([]mapping, error) {
+	if len(configured) == 0 {
+		configured = DefaultMappings
+	}
+
+	mappings := make([]mapping, 0, len(configured))
+	for _, m := range configured {
+		soName, vName := strings.ToLower(m.SuperOffice), strings.ToLower(m.Visma)
+		so, ok := superOfficeFields[soName]
+		if !ok {
+			return nil, fmt.Errorf("unknown SuperOffice field %q", m.SuperOffice)
+		}
+		v, ok := vismaFields[vName]
+		if !ok {
+			return nil, fmt.Errorf("unknown Visma field %q", m.Visma)
+		}
+
+		direction := strings.ToLower(m.Direction)
+		switch direction {
+		case "":
+			direction = DirectionBoth
+		case DirectionBoth, DirectionToVisma, DirectionToSuperOffice:
+		default:
+			return nil, fmt.Errorf("invalid direction %q for %s", m.Direction, m.SuperOffice)
+		}
+
+		mappings = append(mappings, mapping{superOffice: soName, visma: vName, direction: direction, so: so, v: v})
+	}
+	return mappings, nil
+}
+
+// toVisma copies mapped contact fields onto a customer
+func toVisma(mappings []mapping, contact *superoffice.Contact, customer *visma.Customer) {
+	for _, m := range mappings {
+		if m.direction != DirectionToSuperOffice {
+			m.v.set(customer, strings.TrimSpace(m.so.get(contact)))
+		}
+	}
+}
+
+// toSuperOffice copies mapped customer fields onto a contact
+func toSuperOffice(mappings []mapping, customer *visma.Customer, contact *superoffice.Contact) {
+	for _, m := range mappings {
+		if m.direction != DirectionToVisma {
+			m.so.set(contact, strings.TrimSpace(m.v.get(customer)))
+		}
+	}
+}
+
+// contactHash fingerprints the mapped fields of a contact
+func contactHash(mappings []mapping, contact *superoffice.Contact) string {
+	h := sha256.New()
+	for _, m := range mappings {
+		fmt.Fprintf(h, "%s=%s\n", m.superOffice, strings.TrimSpace(m.so.get(contact)))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// customerHash fingerprints the mapped fields of a customer
+func customerHash(mappings []mapping, customer *visma.Customer) string {
+	h := sha256.New()
+	for _, m := range mappings {
+		fmt.Fprintf(h, "%s=%s\n", m.visma, strings.TrimSpace(m.v.get(customer)))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Helper functions
+
+// first returns the first element value
+func first(elements []superoffice.EntityElement) string {
+	if len(elements) == 0 {
+		return ""
+	}
+	return elements[0].Value
+}
+
+// setFirst replaces the first element value, keeping the others
+func setFirst(elements []superoffice.EntityElement, value string) []superoffice.EntityElement {
+	if len(elements) == 0 {
+		if value == "" {
+			return elements
+		}
+		return []superoffice.EntityElement{{Value: value}}
+	}
+	elements[0].Value = value
+	return elements
+}
+
+// addressField maps a field of one of a contact's addresses
+func addressField(which func(*superoffice.ContactAddress) *superoffice.Address, part func(*superoffice.Address) *string) field[superoffice.Contact] {
+	return field[superoffice.Contact]{
+		get: func(c *superoffice.Contact) string {
+			if c.Address == nil {
+				return ""
+			}
+			return *part(which(c.Address))
+		},
+		set: func(c *superoffice.Contact, v string) {
+			if c.Address == nil {
+				c.Address = &superoffice.ContactAddress{}
+			}
+			*part(which(c.Address)) = v
+		},
+	}
+}
+
+// vismaAddressField maps a field of a customer's main address
+func vismaAddressField(part func(*visma.Address) *string) field[visma.Customer] {
+	return field[visma.Customer]{
+		get: func(c *visma.Customer) string {
+			if c.MainAddress == nil {
+				return ""
+			}
+			return *part(c.MainAddress)
+		},
+		set: func(c *visma.Customer, v string) {
+			if c.MainAddress == nil {
+				c.MainAddress = &visma.Address{}
+			}
+			*part(c.MainAddress) = v
+		},
+	}
+}
+
+// vismaContactField maps a field of a customer's main contact
+func vismaContactField(part func(*visma.Contact) *string) field[visma.Customer] {
+	return field[visma.Customer]{
+		get: func(c *visma.Customer) string {
+			if c.MainContact == nil {
+				return ""
+			}
+			return *part(c.MainContact)
+		},
+		set: func(c *visma.Customer, v string) {
+			if c.MainContact == nil {
+				c.MainContact = &visma.Contact{}
+			}
+			*part(c.MainContact) = v
+		},
+	}
+}
@@ -0,0 +1,254 @@
+package customersync
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	_ "github.com/lib/pq"
+)
+
+// ErrRunInProgress is returned when another gateway instance is already syncing
+var ErrRunInProgress = errors.New("customer sync already running")
+
+// runLockKey is the Postgres advisory lock that serializes sync runs across instances
+const runLockKey = 0x5_0FF1CE
+
+// schema creates the sync tables if they do not exist
+const schema = `
+CREATE TABLE IF NOT EXISTS customer_sync_mappings (
+	superoffice_contact_id INTEGER     NOT NULL,
+	visma_company          TEXT        NOT NULL,
+	visma_customer_number  TEXT        NOT NULL,
+	superoffice_hash       TEXT        NOT NULL,
+	visma_hash             TEXT        NOT NULL,
+	synced_at              TIMESTAMPTZ NOT NULL,
+	PRIMARY KEY (superoffice_contact_id, visma_company),
+	UNIQUE (visma_company, visma_customer_number)
+);
+
+CREATE TABLE IF NOT EXISTS customer_sync_cursors (
+	name       TEXT PRIMARY KEY,
+	position   TIMESTAMPTZ NOT NULL,
+	updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS customer_sync_runs (
+	id          BIGSERIAL PRIMARY KEY,
+	trigger     TEXT        NOT NULL,
+	status      TEXT        NOT NULL,
+	started_at  TIMESTAMPTZ NOT NULL,
+	finished_at TIMESTAMPTZ,
+	result      JSONB       NOT NULL
+);
+`
+
+// Mapping links a SuperOffice contact to a Visma customer. The hashes
+// fingerprint the mapped fields of both sides as of the last sync.
+type Mapping struct {
+	SuperOfficeID   int       `json:"superoffice_contact_id"`
+	VismaCompany    string    `json:"visma_company"`
+	VismaNumber     string    `json:"visma_customer_number"`
+	SuperOfficeHash string    `json:"superoffice_hash"`
+	VismaHash       string    `json:"visma_hash"`
+	SyncedAt        time.Time `json:"synced_at"`
+}
+
+// Store keeps mappings, cursors and run history in Postgres
+type Store struct {
+	db *sql.DB
+}
+
+// NewStore connects to Postgres and creates the sync tables
+func NewStore(ctx context.Context, postgresURL string) (*Store, error) {
+	db, err := sql.Open("postgres", postgresURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open connection: %w", err)
+	}
+	db.SetMaxOpenConns(5)
+	db.SetConnMaxLifetime(30 * time.Minute)
+
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("ping failed: %w", err)
+	}
+	if _, err := db.ExecContext(ctx, schema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to create sync tables: %w", err)
+	}
+
+	return &Store{db: db}, nil
+}
+
+// Close closes the database connection
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// lock takes the run lock; the returned function releases it
+func (s *Store) lock(ctx context.Context) (func(), error) {
+	// Advisory locks belong to a session, so hold one connection for the run
+	conn, err := s.db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get connection: %w", err)
+	}
+
+	var locked bool
+	if err := conn.QueryRowContext(ctx, `SELECT pg_try_advisory_lock($1)`, runLockKey).Scan(&locked); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to take sync lock: %w", err)
+	}
+	if !locked {
+		conn.Close()
+		return nil, ErrRunInProgress
+	}
+
+	return func() {
+		_, _ = conn.ExecContext(context.WithoutCancel(ctx), `SELECT pg_advisory_unlock($1)`, runLockKey)
+		conn.Close()
+	}, nil
+}
+
+// Cursor returns the position of a sync cursor (zero if it was never set)
+func (s *Store) Cursor(ctx context.Context, name string) (time.Time, error) {
+	var position time.Time
+	err := s.db.QueryRowContext(ctx, `SELECT position FROM customer_sync_cursors WHERE name = $1`, name).Scan(&position)
+	if errors.Is(err, sql.ErrNoRows) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to read cursor %s: %w", name, err)
+	}
+	return position, nil
+}
+
+// SetCursor moves a sync cursor forward; it never moves back
+func (s *Store) SetCursor(ctx context.Context, name string, position time.Time) error {
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO customer_sync_cursors (name, position, updated_at) VALUES ($1, $2, now())
+		ON CONFLICT (name) DO UPDATE SET
+			position = GREATEST(customer_sync_cursors.position, EXCLUDED.position), updated_at = now()`,
+		name, position)
+	if err != nil {
+		return fmt.Errorf("failed to save cursor %s: %w", name, err)
+	}
+	return nil
+}
+
+// MappingBySuperOffice returns the mapping of a contact, or nil if it has none
+func (s *Store) MappingBySuperOffice(ctx context.Context, company string, contactID int) (*Mapping, error) {
+	return s.mapping(ctx, `superoffice_contact_id = $2`, company, contactID)
+}
+
+// MappingByVisma returns the mapping of a customer, or nil if it has none
+func (s *Store) MappingByVisma(ctx context.Context, company, number string) (*Mapping, error) {
+	return s.mapping(ctx, `visma_customer_number = $2`, company, number)
+}
+
+// mapping reads one mapping of a company
+func (s *Store) mapping(ctx context.Context, where string, company string, key interface{}) (*Mapping, error) {
+	var m Mapping
+	err := s.db.QueryRowContext(ctx, `
+		SELECT superoffice_contact_id, visma_company, visma_customer_number, superoffice_hash, visma_hash, synced_at
+		FROM customer_sync_mappings WHERE visma_company = $1 AND `+where, company, key).
+		Scan(&m.SuperOfficeID, &m.VismaCompany, &m.VismaNumber, &m.SuperOfficeHash, &m.VismaHash, &m.SyncedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read mapping: %w", err)
+	}
+	return &m, nil
+}
+
+// SaveMapping creates or updates a mapping
+func (s *Store) SaveMapping(ctx context.Context, m Mapping) error {
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO customer_sync_mappings
+			(superoffice_contact_id, visma_company, visma_customer_number, superoffice_hash, visma_hash, synced_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (superoffice_contact_id, visma_company) DO UPDATE SET
+			visma_customer_number = EXCLUDED.visma_customer_number,
+			superoffice_hash = EXCLUDED.superoffice_hash,
+			visma_hash = EXCLUDED.visma_hash,
+			synced_at = EXCLUDED.synced_at`,
+		m.SuperOfficeID, m.VismaCompany, m.VismaNumber, m.SuperOfficeHash, m.VismaHash, m.SyncedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save mapping: %w", err)
+	}
+	return nil
+}
+
+// StartRun records a new run and assigns its ID
+func (s *Store) StartRun(ctx context.Context, run *RunResult) error {
+	result, err := json.Marshal(run)
+	if err != nil {
+		return fmt.Errorf("failed to encode run: %w", err)
+	}
+	err = s.db.QueryRowContext(ctx, `
+		INSERT INTO customer_sync_runs (trigger, status, started_at, result) VALUES ($1, $2, $3, $4) RETURNING id`,
+		run.Trigger, run.Status, run.StartedAt, result).Scan(&run.ID)
+	if err != nil {
+		return fmt.Errorf("failed to record run: %w", err)
+	}
+	return nil
+}
+
+// FinishRun stores the outcome of a run
+func (s *Store) FinishRun(ctx context.Context, run *RunResult) error {
+	result, err := json.Marshal(run)
+	if err != nil {
+		return fmt.Errorf("failed to encode run: %w", err)
+	}
+	_, err = s.db.ExecContext(ctx, `
+		UPDATE customer_sync_runs SET status = $2, finished_at = $3, result = $4 WHERE id = $1`,
+		run.ID, run.Status, run.FinishedAt, result)
+	if err != nil {
+		return fmt.Errorf("failed to record run: %w", err)
+	}
+	return nil
+}
+
+// Run returns a recorded run
+func (s *Store) Run(ctx context.Context, id int64) (*RunResult, error) {
+	var result []byte
+	err := s.db.QueryRowContext(ctx, `SELECT result FROM customer_sync_runs WHERE id = $1`, id).Scan(&result)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("sync run %d not found", id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read run: %w", err)
+	}
+
+	var run RunResult
+	if err := json.Unmarshal(result, &run); err != nil {
+		return nil, fmt.Errorf("failed to decode run: %w", err)
+	}
+	return &run, nil
+}
+
+// Runs returns the most recent runs, newest first
+func (s *Store) Runs(ctx context.Context, limit int) ([]RunResult, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT result FROM customer_sync_runs ORDER BY id DESC LIMIT $1`, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list runs: %w", err)
+	}
+	defer rows.Close()
+
+	var runs []RunResult
+	for rows.Next() {
+		var result []byte
+		if err := rows.Scan(&result); err != nil {
+			return nil, fmt.Errorf("failed to read run: %w", err)
+		}
+		var run RunResult
+		if err := json.Unmarshal(result, &run); err != nil {
+			return nil, fmt.Errorf("failed to decode run: %w", err)
+		}
+		runs = append(runs, run)
+	}
+	return runs, rows.Err()
+}
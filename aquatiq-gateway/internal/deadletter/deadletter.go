@@ -0,0 +1,504 @@
+// Package deadletter keeps integration writes that failed after all retries,
+// or were rejected by an open circuit breaker, so they are not lost. A
+// scheduler retries them with backoff once the integration's breaker has
+// closed again; operators can inspect, replay, edit or discard them.
+package deadletter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/aquatiq/integration-gateway/internal/audit"
+	"github.com/aquatiq/integration-gateway/pkg/httpclient"
+)
+
+// Entry statuses
+const (
+	StatusPending   = "pending"   // Waiting for a scheduled retry
+	StatusFailed    = "failed"    // Retries exhausted or rejected by the provider; waits for an operator
+	StatusReplayed  = "replayed"  // Sent successfully
+	StatusDiscarded = "discarded" // Dropped by an operator
+)
+
+// Attempt triggers
+const (
+	TriggerOriginal  = "original"  // The call that failed
+	TriggerScheduler = "scheduler" // A scheduled retry
+	TriggerReplay    = "replay"    // A replay requested by an operator
+)
+
+// maxAttemptHistory caps the attempts kept per entry
+const maxAttemptHistory = 50
+
+var (
+	// ErrNotFound is returned for unknown entries
+	ErrNotFound = errors.New("dead letter not found")
+	// ErrClosed is returned when changing an entry that was already replayed or discarded
+	ErrClosed = errors.New("dead letter already replayed or discarded")
+)
+
+// Entry is a failed write with its attempt history
+type Entry struct {
+	ID            int64       `json:"id"`
+	Service       string      `json:"service"`
+	Method        string      `json:"method"`
+	URL           string      `json:"url"`
+	Header        http.Header `json:"header,omitempty"`
+	Payload       string      `json:"payload"`
+	Status        string      `json:"status"`
+	Retries       int         `json:"retries"` // Scheduled retries so far
+	LastError     string      `json:"last_error,omitempty"`
+	Note          string      `json:"note,omitempty"` // Why an operator edited or discarded the entry
+	Attempts      []Attempt   `json:"attempts"`
+	NextAttemptAt time.Time   `json:"next_attempt_at,omitempty"`
+	CreatedAt     time.Time   `json:"created_at"`
+	UpdatedAt     time.Time   `json:"updated_at"`
+}
+
+// Attempt is one try at sending an entry
+type Attempt struct {
+	At         time.Time `json:"at"`
+	Trigger    string    `json:"trigger"`
+	Success    bool      `json:"success"`
+	Error      string    `json:"error,omitempty"`
+	StatusCode int       `json:"status_code,omitempty"`
+}
+
+// open reports whether the entry can still be replayed, edited or discarded
+func (e *Entry) open() bool {
+	return e.Status == StatusPending || e.Status == StatusFailed
+}
+
+// Filter selects entries to list
+type Filter struct {
+	Service string
+	Status  string
+	Limit   int // Default 50
+	Offset  int
+}
+
+// Store persists dead letters
+type Store interface {
+	// Add stores a new entry and sets its ID
+	Add(ctx context.Context, entry *Entry) error
+	// Get returns an entry or ErrNotFound
+	Get(ctx context.Context, id int64) (*Entry, error)
+	// List returns entries, newest first
+	List(ctx context.Context, filter Filter) ([]Entry, error)
+	// Claim returns pending entries of a service that are due and postpones
+	// them by lease, so other gateway instances skip them meanwhile
+	Claim(ctx context.Context, service string, now time.Time, lease time.Duration, limit int) ([]Entry, error)
+	// Update saves an entry
+	Update(ctx context.Context, entry *Entry) error
+	// Counts returns the number of entries per status
+	Counts(ctx context.Context) (map[string]int, error)
+	// Close releases the store
+	Close() error
+}
+
+// Config holds dead-letter queue configuration
+type Config struct {
+	Store       Store
+	Interval    time.Duration // Time between scheduler passes (default 30s)
+	BatchSize   int           // Entries retried per service and pass (default 20)
+	MaxAttempts int           // Scheduled retries before an entry is marked failed (default 8)
+	BaseDelay   time.Duration // Delay before the first retry, doubled per retry (default 1m)
+	MaxDelay    time.Duration // Longest delay between retries (default 6h)
+	AuditLogger *audit.AuditLogger
+}
+
+// Stats holds dead-letter statistics
+type Stats struct {
+	Counts   map[string]int `json:"counts"` // Entries per status
+	Captured uint64         `json:"captured"`
+	Replayed uint64         `json:"replayed"`
+	Retried  uint64         `json:"retried"`
+}
+
+// Queue captures failed writes and retries them
+type Queue struct {
+	store  Store
+	config Config
+	audit  *audit.AuditLogger
+
+	mu       sync.RWMutex
+	clients  map[string]*httpclient.Client
+	captured uint64
+	replayed uint64
+	retried  uint64
+}
+
+// New creates a dead-letter queue
+func New(cfg Config) (*Queue, error) {
+	if cfg.Store == nil {
+		return nil, fmt.Errorf("dead-letter store is required")
+	}
+	if cfg.Interval <= 0 {
+		cfg.Interval = 30 * time.Second
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 20
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 8
+	}
+	if cfg.BaseDelay <= 0 {
+		cfg.BaseDelay = time.Minute
+	}
+	if cfg.MaxDelay <= 0 {
+		cfg.MaxDelay = 6 * time.Hour
+	}
+
+	return &Queue{
+		store:   cfg.Store,
+		config:  cfg,
+		audit:   cfg.AuditLogger,
+		clients: make(map[string]*httpclient.Client),
+	}, nil
+}
+
+// Register sets the client entries of a service are replayed through
+func (q *Queue) Register(service string, client *httpclient.Client) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.clients[service] = client
+}
+
+// Capture stores a failed write; it implements httpclient.DeadLetterSink
+func (q *Queue) Capture(ctx context.Context, write httpclient.FailedWrite) error {
+	entry := &Entry{
+		Service: write.Service,
+		Method:  write.Method,
+		URL:     write.URL,
+		Header:  write.Header,
+		Payload: string(write.Body),
+		Status:  StatusPending,
+		Attempts: []Attempt{{
+			At:         write.FailedAt,
+			Trigger:    TriggerOriginal,
+			Error:      write.Error,
+			StatusCode: write.StatusCode,
+		}},
+		LastError:     write.Error,
+		NextAttemptAt: write.FailedAt.Add(q.config.BaseDelay),
+		CreatedAt:     write.FailedAt,
+		UpdatedAt:     write.FailedAt,
+	}
+	if err := q.store.Add(ctx, entry); err != nil {
+		return fmt.Errorf("failed to store dead letter: %w", err)
+	}
+
+	q.mu.Lock()
+	q.captured++
+	q.mu.Unlock()
+
+	q.logEvent("dead_letter_captured", entry, true, "", map[string]string{
+		"breaker_open": strconv.FormatBool(write.BreakerOpen),
+	})
+	return nil
+}
+
+// Start runs the retry scheduler until the context is cancelled
+func (q *Queue) Start(ctx context.Context) {
+	ticker := time.NewTicker(q.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			q.retryDue(ctx)
+		}
+	}
+}
+
+// Get returns an entry
+func (q *Queue) Get(ctx context.Context, id int64) (*Entry, error) {
+	return q.store.Get(ctx, id)
+}
+
+// List returns entries, newest first
+func (q *Queue) List(ctx context.Context, filter Filter) ([]Entry, error) {
+	if filter.Limit <= 0 || filter.Limit > 500 {
+		filter.Limit = 50
+	}
+	return q.store.List(ctx, filter)
+}
+
+// Replay sends a pending or failed entry now, regardless of its schedule;
+// the returned entry's status tells whether it went through. The entry keeps
+// the idempotency key of the original call, so providers that deduplicate
+// by key do not apply it twice.
+func (q *Queue) Replay(ctx context.Context, id int64) (*Entry, error) {
+	entry, err := q.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !entry.open() {
+		return entry, ErrClosed
+	}
+	if err := q.send(ctx, entry, TriggerReplay); err != nil {
+		return entry, err
+	}
+	return entry, nil
+}
+
+// Edit replaces the payload of a pending or failed entry. The entry gets a
+// new idempotency key, since the provider may have remembered the old one
+// with the old payload.
+func (q *Queue) Edit(ctx context.Context, id int64, payload, note string) (*Entry, error) {
+	entry, err := q.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !entry.open() {
+		return entry, ErrClosed
+	}
+
+	entry.Payload = payload
+	entry.Note = note
+	if client := q.client(entry.Service); client != nil {
+		entry.Header.Del(client.IdempotencyHeader())
+	}
+	entry.UpdatedAt = time.Now()
+	if err := q.store.Update(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	q.logEvent("dead_letter_edited", entry, true, "", nil)
+	return entry, nil
+}
+
+// Discard drops a pending or failed entry; it is kept for reference but never sent
+func (q *Queue) Discard(ctx context.Context, id int64, note string) (*Entry, error) {
+	entry, err := q.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !entry.open() {
+		return entry, ErrClosed
+	}
+
+	entry.Status = StatusDiscarded
+	entry.Note = note
+	entry.NextAttemptAt = time.Time{}
+	entry.UpdatedAt = time.Now()
+	if err := q.store.Update(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	q.logEvent("dead_letter_discarded", entry, true, "", nil)
+	return entry, nil
+}
+
+// Stats returns entry counts and capture and replay counters
+func (q *Queue) Stats(ctx context.Context) (Stats, error) {
+	counts, err := q.store.Counts(ctx)
+	if err != nil {
+		return Stats{}, err
+	}
+
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	return Stats{
+		Counts:   counts,
+		Captured: q.captured,
+		Replayed: q.replayed,
+		Retried:  q.retried,
+	}, nil
+}
+
+// Close closes the store
+func (q *Queue) Close() error {
+	return q.store.Close()
+}
+
+// retryDue retries the due entries of every service whose circuit breaker is not open
+func (q *Queue) retryDue(ctx context.Context) {
+	q.mu.RLock()
+	clients := make(map[string]*httpclient.Client, len(q.clients))
+	for service, client := range q.clients {
+		clients[service] = client
+	}
+	q.mu.RUnlock()
+
+	for service, client := range clients {
+		// While the breaker is open the provider has not recovered; once it
+		// turns half-open, a single entry serves as the trial request
+		limit := q.config.BatchSize
+		switch client.BreakerState() {
+		case "open":
+			continue
+		case "half-open":
+			limit = 1
+		}
+
+		// The lease covers the whole batch, so a slow batch is not picked up twice
+		lease := time.Duration(limit)*time.Minute + q.config.Interval
+		entries, err := q.store.Claim(ctx, service, time.Now(), lease, limit)
+		if err != nil {
+			q.logError("dead_letter_claim", service, err)
+			continue
+		}
+
+		for i := range entries {
+			if ctx.Err() != nil {
+				return
+			}
+			if err := q.send(ctx, &entries[i], TriggerScheduler); err != nil {
+				q.logError("dead_letter_retry", service, err)
+			}
+			// Stop early when the provider fails again; the breaker decides when to resume
+			if client.BreakerState() != "closed" {
+				break
+			}
+		}
+	}
+}
+
+// send replays an entry through its service's client and records the
+// attempt; the outcome is in the entry, errors mean it could not be sent or saved
+func (q *Queue) send(ctx context.Context, entry *Entry, trigger string) error {
+	client := q.client(entry.Service)
+	if client == nil {
+		return fmt.Errorf("no client registered for %s", entry.Service)
+	}
+
+	req, err := http.NewRequestWithContext(httpclient.WithoutDeadLetter(ctx), entry.Method, entry.URL, bytes.NewReader([]byte(entry.Payload)))
+	if err != nil {
+		return fmt.Errorf("invalid dead letter request: %w", err)
+	}
+	req.Header = entry.Header.Clone()
+	if req.Header == nil {
+		req.Header = http.Header{}
+	}
+
+	attempt := Attempt{At: time.Now(), Trigger: trigger}
+	resp, callErr := client.Do(req)
+	if callErr == nil {
+		resp.Body.Close()
+	}
+
+	now := time.Now()
+	var statusErr *httpclient.StatusError
+	if errors.As(callErr, &statusErr) {
+		attempt.StatusCode = statusErr.StatusCode
+	}
+	switch {
+	case callErr == nil:
+		attempt.Success = true
+		entry.Status = StatusReplayed
+		entry.LastError = ""
+		entry.NextAttemptAt = time.Time{}
+	case statusErr != nil && statusErr.StatusCode >= 400 && statusErr.StatusCode < 500 && statusErr.StatusCode != http.StatusTooManyRequests:
+		// The provider rejected the payload; sending it again unchanged will not help
+		entry.Status = StatusFailed
+		entry.NextAttemptAt = time.Time{}
+	default:
+		if trigger == TriggerScheduler {
+			entry.Retries++
+		}
+		if entry.Retries >= q.config.MaxAttempts {
+			entry.Status = StatusFailed
+			entry.NextAttemptAt = time.Time{}
+		} else if entry.Status == StatusPending {
+			entry.NextAttemptAt = now.Add(q.backoff(entry.Retries))
+		}
+	}
+	if callErr != nil {
+		attempt.Error = callErr.Error()
+		entry.LastError = callErr.Error()
+	}
+
+	entry.Attempts = append(entry.Attempts, attempt)
+	if len(entry.Attempts) > maxAttemptHistory {
+		// Keep the original failure and the most recent attempts
+		entry.Attempts = append(entry.Attempts[:1], entry.Attempts[len(entry.Attempts)-maxAttemptHistory+1:]...)
+	}
+	entry.UpdatedAt = now
+
+	// Save with a fresh context, so a cancelled caller cannot lose the outcome
+	saveCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+	defer cancel()
+	if err := q.store.Update(saveCtx, entry); err != nil {
+		return fmt.Errorf("failed to save dead letter %d: %w", entry.ID, err)
+	}
+
+	q.mu.Lock()
+	if trigger == TriggerScheduler {
+		q.retried++
+	}
+	if callErr == nil {
+		q.replayed++
+	}
+	q.mu.Unlock()
+
+	q.logEvent("dead_letter_"+trigger, entry, callErr == nil, entry.LastError, map[string]string{
+		"attempts": strconv.Itoa(len(entry.Attempts)),
+		"status":   entry.Status,
+	})
+	return nil
+}
+
+// Helper functions
+
+// client returns the client registered for a service
+func (q *Queue) client(service string) *httpclient.Client {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	return q.clients[service]
+}
+
+// backoff returns the delay before the next scheduled retry
+func (q *Queue) backoff(retries int) time.Duration {
+	delay := q.config.BaseDelay
+	for i := 0; i < retries && delay < q.config.MaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, q.config.MaxDelay)
+}
+
+// logEvent audits a change to an entry; payloads are never logged
+func (q *Queue) logEvent(action string, entry *Entry, success bool, errMsg string, details map[string]string) {
+	if q.audit == nil {
+		return
+	}
+	if details == nil {
+		details = make(map[string]string)
+	}
+	details["service"] = entry.Service
+	details["method"] = entry.Method
+
+	q.audit.LogEvent(audit.AuditEvent{
+		Timestamp: time.Now(),
+		Action:    action,
+		Actor:     "gateway",
+		Resource:  "deadletter:" + strconv.FormatInt(entry.ID, 10),
+		Success:   success,
+		Error:     errMsg,
+		Details:   details,
+	})
+}
+
+// logError audits a scheduler failure that is not tied to a replay outcome
+func (q *Queue) logError(action, service string, err error) {
+	if q.audit == nil {
+		return
+	}
+	q.audit.LogEvent(audit.AuditEvent{
+		Timestamp: time.Now(),
+		Action:    action,
+		Actor:     "gateway",
+		Resource:  "deadletter",
+		Success:   false,
+		Error:     err.Error(),
+		Details:   map[string]string{"service": service},
+	})
+}
@@ -0,0 +1,185 @@
+package deadletter
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/aquatiq/integration-gateway/internal/audit"
+	"github.com/go-chi/chi/v5"
+)
+
+// Handler serves the dead-letter REST API
+type Handler struct {
+	queue *Queue
+	audit *audit.AuditLogger
+}
+
+// NewHandler creates the dead-letter REST handler
+func NewHandler(queue *Queue, auditLogger *audit.AuditLogger) *Handler {
+	return &Handler{queue: queue, audit: auditLogger}
+}
+
+// editRequest is the body of edit and discard requests
+type editRequest struct {
+	Payload *string `json:"payload"`
+	Note    string  `json:"note"`
+}
+
+// List returns entries, filtered by ?service= and ?status=, newest first
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	limit, _ := strconv.Atoi(query.Get("limit"))
+	offset, _ := strconv.Atoi(query.Get("offset"))
+
+	entries, err := h.queue.List(r.Context(), Filter{
+		Service: query.Get("service"),
+		Status:  query.Get("status"),
+		Limit:   limit,
+		Offset:  max(offset, 0),
+	})
+	if err != nil {
+		h.respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	h.respondJSON(w, http.StatusOK, map[string]interface{}{
+		"entries": entries,
+		"count":   len(entries),
+	})
+}
+
+// Stats returns entry counts per status and capture and replay counters
+func (h *Handler) Stats(w http.ResponseWriter, r *http.Request) {
+	stats, err := h.queue.Stats(r.Context())
+	if err != nil {
+		h.respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	h.respondJSON(w, http.StatusOK, stats)
+}
+
+// Get returns one entry with its payload and attempt history
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.entryID(w, r)
+	if !ok {
+		return
+	}
+	entry, err := h.queue.Get(r.Context(), id)
+	if err != nil {
+		h.respondEntryError(w, err)
+		return
+	}
+	h.respondJSON(w, http.StatusOK, entry)
+}
+
+// Replay sends an entry now; the response holds the entry with the outcome
+func (h *Handler) Replay(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	id, ok := h.entryID(w, r)
+	if !ok {
+		return
+	}
+
+	entry, err := h.queue.Replay(r.Context(), id)
+	h.logRequest(r, "dead_letter_replay", err == nil && entry.Status == StatusReplayed, err, start)
+	if err != nil {
+		h.respondEntryError(w, err)
+		return
+	}
+	h.respondJSON(w, http.StatusOK, entry)
+}
+
+// Edit replaces the payload of an entry
+func (h *Handler) Edit(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	id, ok := h.entryID(w, r)
+	if !ok {
+		return
+	}
+
+	var req editRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 10<<20)).Decode(&req); err != nil || req.Payload == nil {
+		h.respondError(w, http.StatusBadRequest, "body must be {\"payload\": \"...\", \"note\": \"...\"}")
+		return
+	}
+
+	entry, err := h.queue.Edit(r.Context(), id, *req.Payload, req.Note)
+	h.logRequest(r, "dead_letter_edit", err == nil, err, start)
+	if err != nil {
+		h.respondEntryError(w, err)
+		return
+	}
+	h.respondJSON(w, http.StatusOK, entry)
+}
+
+// Discard drops an entry; an optional {"note": "..."} body records why
+func (h *Handler) Discard(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	id, ok := h.entryID(w, r)
+	if !ok {
+		return
+	}
+
+	var req editRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<20)).Decode(&req); err != nil {
+			h.respondError(w, http.StatusBadRequest, "invalid request body")
+			return
+		}
+	}
+
+	entry, err := h.queue.Discard(r.Context(), id, req.Note)
+	h.logRequest(r, "dead_letter_discard", err == nil, err, start)
+	if err != nil {
+		h.respondEntryError(w, err)
+		return
+	}
+	h.respondJSON(w, http.StatusOK, entry)
+}
+
+// Helper functions
+
+// entryID parses the {id} URL parameter
+func (h *Handler) entryID(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || id <= 0 {
+		h.respondError(w, http.StatusBadRequest, "invalid dead letter ID")
+		return 0, false
+	}
+	return id, true
+}
+
+// logRequest audits an operator action
+func (h *Handler) logRequest(r *http.Request, action string, success bool, err error, start time.Time) {
+	if h.audit != nil {
+		h.audit.LogHTTPRequest(r, action, success, err, time.Since(start))
+	}
+}
+
+// respondEntryError maps queue errors to status codes
+func (h *Handler) respondEntryError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, ErrNotFound):
+		h.respondError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, ErrClosed):
+		h.respondError(w, http.StatusConflict, err.Error())
+	default:
+		h.respondError(w, http.StatusInternalServerError, err.Error())
+	}
+}
+
+// respondError writes a JSON error response
+func (h *Handler) respondError(w http.ResponseWriter, status int, message string) {
+	h.respondJSON(w, status, map[string]string{
+		"error": message,
+	})
+}
+
+// respondJSON writes a JSON response
+func (h *Handler) respondJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,136 @@
+package deadletter
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MemoryStore keeps dead letters in process memory. Entries are lost on
+// restart and not shared between instances, so it only suits development
+// and single-instance deployments without Postgres.
+type MemoryStore struct {
+	mu      sync.Mutex
+	entries map[int64]*Entry
+	nextID  int64
+}
+
+// NewMemoryStore creates an in-memory dead-letter store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{entries: make(map[int64]*Entry)}
+}
+
+// Add stores a new entry and sets its ID
+func (s *MemoryStore) Add(ctx context.Context, entry *Entry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nextID++
+	entry.ID = s.nextID
+	s.entries[entry.ID] = cloneEntry(entry)
+	return nil
+}
+
+// Get returns an entry or ErrNotFound
+func (s *MemoryStore) Get(ctx context.Context, id int64) (*Entry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.entries[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return cloneEntry(entry), nil
+}
+
+// List returns entries, newest first
+func (s *MemoryStore) List(ctx context.Context, filter Filter) ([]Entry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	matched := []Entry{}
+	for _, entry := range s.sorted(func(a, b *Entry) bool { return a.ID > b.ID }) {
+		if (filter.Service == "" || entry.Service == filter.Service) && (filter.Status == "" || entry.Status == filter.Status) {
+			matched = append(matched, *cloneEntry(entry))
+		}
+	}
+
+	if filter.Offset >= len(matched) {
+		return []Entry{}, nil
+	}
+	matched = matched[filter.Offset:]
+	if filter.Limit > 0 && len(matched) > filter.Limit {
+		matched = matched[:filter.Limit]
+	}
+	return matched, nil
+}
+
+// Claim returns due pending entries of a service and postpones them by lease
+func (s *MemoryStore) Claim(ctx context.Context, service string, now time.Time, lease time.Duration, limit int) ([]Entry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	claimed := []Entry{}
+	for _, entry := range s.sorted(func(a, b *Entry) bool { return a.NextAttemptAt.Before(b.NextAttemptAt) }) {
+		if len(claimed) >= limit {
+			break
+		}
+		if entry.Service != service || entry.Status != StatusPending || entry.NextAttemptAt.After(now) {
+			continue
+		}
+		entry.NextAttemptAt = now.Add(lease)
+		claimed = append(claimed, *cloneEntry(entry))
+	}
+	return claimed, nil
+}
+
+// Update saves an entry
+func (s *MemoryStore) Update(ctx context.Context, entry *Entry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.entries[entry.ID]; !ok {
+		return ErrNotFound
+	}
+	s.entries[entry.ID] = cloneEntry(entry)
+	return nil
+}
+
+// Counts returns the number of entries per status
+func (s *MemoryStore) Counts(ctx context.Context) (map[string]int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	counts := make(map[string]int)
+	for _, entry := range s.entries {
+		counts[entry.Status]++
+	}
+	return counts, nil
+}
+
+// Close is a no-op
+func (s *MemoryStore) Close() error {
+	return nil
+}
+
+// sorted returns the stored entries in the given order; the caller holds the lock
+func (s *MemoryStore) sorted(less func(a, b *Entry) bool) []*Entry {
+	entries := make([]*Entry, 0, len(s.entries))
+	for _, entry := range s.entries {
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool { return less(entries[i], entries[j]) })
+	return entries
+}
+
+// Helper functions
+
+// cloneEntry returns a deep copy, so callers cannot change stored entries
+func cloneEntry(entry *Entry) *Entry {
+	data, _ := json.Marshal(entry)
+	var out Entry
+	_ = json.Unmarshal(data, &out)
+	return &out
+}
@@ -0,0 +1,254 @@
+package deadletter
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	_ "github.com/lib/pq"
+)
+
+// schema creates the dead-letter table if it does not exist
+const schema = `
+CREATE TABLE IF NOT EXISTS dead_letters (
+	id              BIGSERIAL PRIMARY KEY,
+	service         TEXT        NOT NULL,
+	method          TEXT        NOT NULL,
+	url             TEXT        NOT NULL,
+	header          JSONB       NOT NULL,
+	payload         TEXT        NOT NULL,
+	status          TEXT        NOT NULL,
+	retries         INTEGER     NOT NULL,
+	last_error      TEXT        NOT NULL,
+	note            TEXT        NOT NULL,
+	attempts        JSONB       NOT NULL,
+	next_attempt_at TIMESTAMPTZ,
+	created_at      TIMESTAMPTZ NOT NULL,
+	updated_at      TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS dead_letters_due ON dead_letters (service, next_attempt_at) WHERE status = 'pending';
+`
+
+// columns are the entry columns, in scan order
+const columns = `id, service, method, url, header, payload, status, retries, last_error, note, attempts, next_attempt_at, created_at, updated_at`
+
+// PostgresStore keeps dead letters in Postgres, shared by all gateway instances
+type PostgresStore struct {
+	db *sql.DB
+}
+
+// NewPostgresStore connects to Postgres and creates the dead-letter table
+func NewPostgresStore(ctx context.Context, postgresURL string) (*PostgresStore, error) {
+	db, err := sql.Open("postgres", postgresURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open connection: %w", err)
+	}
+	db.SetMaxOpenConns(5)
+	db.SetConnMaxLifetime(30 * time.Minute)
+
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("ping failed: %w", err)
+	}
+	if _, err := db.ExecContext(ctx, schema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to create dead-letter table: %w", err)
+	}
+
+	return &PostgresStore{db: db}, nil
+}
+
+// Add stores a new entry and sets its ID
+func (s *PostgresStore) Add(ctx context.Context, entry *Entry) error {
+	header, attempts, err := encodeEntry(entry)
+	if err != nil {
+		return err
+	}
+
+	return s.db.QueryRowContext(ctx, `
+		INSERT INTO dead_letters (service, method, url, header, payload, status, retries, last_error, note, attempts, next_attempt_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		RETURNING id`,
+		entry.Service, entry.Method, entry.URL, header, entry.Payload, entry.Status, entry.Retries,
+		entry.LastError, entry.Note, attempts, nullTime(entry.NextAttemptAt), entry.CreatedAt, entry.UpdatedAt,
+	).Scan(&entry.ID)
+}
+
+// Get returns an entry or ErrNotFound
+func (s *PostgresStore) Get(ctx context.Context, id int64) (*Entry, error) {
+	entry, err := scanEntry(s.db.QueryRowContext(ctx, `SELECT `+columns+` FROM dead_letters WHERE id = $1`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	return entry, err
+}
+
+// List returns entries, newest first
+func (s *PostgresStore) List(ctx context.Context, filter Filter) ([]Entry, error) {
+	var where []string
+	var args []interface{}
+	if filter.Service != "" {
+		args = append(args, filter.Service)
+		where = append(where, fmt.Sprintf("service = $%d", len(args)))
+	}
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		where = append(where, fmt.Sprintf("status = $%d", len(args)))
+	}
+
+	query := `SELECT ` + columns + ` FROM dead_letters`
+	if len(where) > 0 {
+		query += ` WHERE ` + strings.Join(where, " AND ")
+	}
+	args = append(args, filter.Limit, filter.Offset)
+	query += fmt.Sprintf(` ORDER BY id DESC LIMIT $%d OFFSET $%d`, len(args)-1, len(args))
+
+	return s.query(ctx, query, args...)
+}
+
+// Claim returns due pending entries of a service and postpones them by lease
+func (s *PostgresStore) Claim(ctx context.Context, service string, now time.Time, lease time.Duration, limit int) ([]Entry, error) {
+	// SKIP LOCKED lets concurrent instances claim disjoint batches
+	return s.query(ctx, `
+		UPDATE dead_letters SET next_attempt_at = $3
+		WHERE id IN (
+			SELECT id FROM dead_letters
+			WHERE service = $1 AND status = 'pending' AND next_attempt_at <= $2
+			ORDER BY next_attempt_at
+			LIMIT $4
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING `+columns,
+		service, now, now.Add(lease), limit,
+	)
+}
+
+// Update saves an entry
+func (s *PostgresStore) Update(ctx context.Context, entry *Entry) error {
+	header, attempts, err := encodeEntry(entry)
+	if err != nil {
+		return err
+	}
+
+	result, err := s.db.ExecContext(ctx, `
+		UPDATE dead_letters SET header = $2, payload = $3, status = $4, retries = $5, last_error = $6,
+			note = $7, attempts = $8, next_attempt_at = $9, updated_at = $10
+		WHERE id = $1`,
+		entry.ID, header, entry.Payload, entry.Status, entry.Retries, entry.LastError,
+		entry.Note, attempts, nullTime(entry.NextAttemptAt), entry.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update dead letter: %w", err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Counts returns the number of entries per status
+func (s *PostgresStore) Counts(ctx context.Context) (map[string]int, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT status, COUNT(*) FROM dead_letters GROUP BY status`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count dead letters: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var status string
+		var n int
+		if err := rows.Scan(&status, &n); err != nil {
+			return nil, err
+		}
+		counts[status] = n
+	}
+	return counts, rows.Err()
+}
+
+// Close closes the database connection
+func (s *PostgresStore) Close() error {
+	return s.db.Close()
+}
+
+// query runs a query returning entries
+func (s *PostgresStore) query(ctx context.Context, query string, args ...interface{}) ([]Entry, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query dead letters: %w", err)
+	}
+	defer rows.Close()
+
+	entries := []Entry{}
+	for rows.Next() {
+		entry, err := scanEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, *entry)
+	}
+	return entries, rows.Err()
+}
+
+// Helper functions
+
+// scanner is implemented by *sql.Row and *sql.Rows
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanEntry reads an entry in column order
+func scanEntry(row scanner) (*Entry, error) {
+	var entry Entry
+	var header, attempts []byte
+	var next sql.NullTime
+	err := row.Scan(&entry.ID, &entry.Service, &entry.Method, &entry.URL, &header, &entry.Payload, &entry.Status,
+		&entry.Retries, &entry.LastError, &entry.Note, &attempts, &next, &entry.CreatedAt, &entry.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	entry.Header = http.Header{}
+	if err := json.Unmarshal(header, &entry.Header); err != nil {
+		return nil, fmt.Errorf("invalid dead letter header: %w", err)
+	}
+	if err := json.Unmarshal(attempts, &entry.Attempts); err != nil {
+		return nil, fmt.Errorf("invalid dead letter attempts: %w", err)
+	}
+	if next.Valid {
+		entry.NextAttemptAt = next.Time
+	}
+	return &entry, nil
+}
+
+// encodeEntry encodes the JSON columns of an entry
+func encodeEntry(entry *Entry) ([]byte, []byte, error) {
+	header := entry.Header
+	if header == nil {
+		header = http.Header{}
+	}
+	headerJSON, err := json.Marshal(header)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encode header: %w", err)
+	}
+	attempts := entry.Attempts
+	if attempts == nil {
+		attempts = []Attempt{}
+	}
+	attemptsJSON, err := json.Marshal(attempts)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encode attempts: %w", err)
+	}
+	return headerJSON, attemptsJSON, nil
+}
+
+// nullTime stores zero times as NULL
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
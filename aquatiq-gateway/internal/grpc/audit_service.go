@@ -0,0 +1,280 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	auditv1 "github.com/aquatiq/integration-gateway/api/proto/audit/v1"
+	"github.com/aquatiq/integration-gateway/internal/audit"
+	"github.com/aquatiq/integration-gateway/internal/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// exportChunkSize is the size at which export data is sent as a chunk
+const exportChunkSize = 64 << 10
+
+// AuditServiceServer implements the gRPC AuditService
+type AuditServiceServer struct {
+	auditv1.UnimplementedAuditServiceServer
+	logger    *audit.AuditLogger
+	publicKey ed25519.PublicKey
+	lifecycle *audit.Lifecycle
+}
+
+// NewAuditServiceServer creates a new gRPC audit service server; publicKey
+// checks checkpoint signatures when a request names none, and lifecycle,
+// when not nil, serves erasures
+func NewAuditServiceServer(logger *audit.AuditLogger, publicKey ed25519.PublicKey, lifecycle *audit.Lifecycle) *AuditServiceServer {
+	return &AuditServiceServer{
+		logger:    logger,
+		publicKey: publicKey,
+		lifecycle: lifecycle,
+	}
+}
+
+// SearchEvents returns matching events, newest first, a page at a time
+func (s *AuditServiceServer) SearchEvents(ctx context.Context, req *auditv1.SearchEventsRequest) (*auditv1.SearchEventsResponse, error) {
+	searcher := s.logger.Searcher()
+	if searcher == nil {
+		return &auditv1.SearchEventsResponse{Success: false, Message: "Searching requires the audit.postgres store"}, nil
+	}
+
+	page, err := searcher.Search(ctx, auditFilterFromProto(req.Filter), int(req.PageSize), req.PageToken)
+	if err != nil {
+		return &auditv1.SearchEventsResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	events := make([]*auditv1.Event, len(page.Records))
+	for i, record := range page.Records {
+		events[i] = auditEventToProto(record)
+	}
+	return &auditv1.SearchEventsResponse{Success: true, Events: events, NextPageToken: page.NextToken}, nil
+}
+
+// ExportEvents streams matching events, oldest first, as JSON lines or CSV
+func (s *AuditServiceServer) ExportEvents(req *auditv1.ExportEventsRequest, stream auditv1.AuditService_ExportEventsServer) error {
+	searcher := s.logger.Searcher()
+	if searcher == nil {
+		return status.Error(codes.FailedPrecondition, "exporting requires the audit.postgres store")
+	}
+
+	var buf bytes.Buffer
+	writer, err := audit.NewExportWriter(&buf, req.Format)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	start := time.Now()
+	var total, pending uint64
+	send := func() error {
+		if err := writer.Flush(); err != nil {
+			return err
+		}
+		if buf.Len() == 0 {
+			return nil
+		}
+		if err := stream.Send(&auditv1.ExportChunk{Data: bytes.Clone(buf.Bytes()), Events: pending}); err != nil {
+			return err
+		}
+		buf.Reset()
+		pending = 0
+		return nil
+	}
+
+	err = searcher.Export(stream.Context(), auditFilterFromProto(req.Filter), func(record audit.Record) error {
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+		total++
+		pending++
+		if buf.Len() >= exportChunkSize {
+			return send()
+		}
+		return nil
+	})
+	if err == nil {
+		err = send()
+	}
+	s.logExport(stream.Context(), req, total, err, time.Since(start))
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// VerifyChain checks the hash chain of a store and reports the first broken link
+func (s *AuditServiceServer) VerifyChain(ctx context.Context, req *auditv1.VerifyChainRequest) (*auditv1.VerifyChainResponse, error) {
+	source, store, err := s.store(req.Source)
+	if err != nil {
+		return &auditv1.VerifyChainResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	publicKey := s.publicKey
+	if req.PublicKey != "" {
+		if publicKey, err = audit.ParsePublicKey(req.PublicKey); err != nil {
+			return &auditv1.VerifyChainResponse{Success: false, Message: err.Error()}, nil
+		}
+	}
+
+	report, err := audit.Verify(ctx, source, store, audit.VerifyOptions{PublicKey: publicKey, MinRetention: s.lifecycle.MinRetention(), Sink: source})
+	if err != nil {
+		return &auditv1.VerifyChainResponse{Success: false, Message: err.Error(), Source: source}, nil
+	}
+
+	resp := &auditv1.VerifyChainResponse{
+		Success:     true,
+		Message:     "Chain intact",
+		Source:      report.Source,
+		Valid:       report.OK,
+		Events:      report.Events,
+		Unchained:   report.Unchained,
+		Checkpoints: report.Checkpoints,
+		Unverified:  report.Unverified,
+		Pruned:      report.Pruned,
+	}
+	for _, c := range report.Chains {
+		resp.Chains = append(resp.Chains, &auditv1.ChainReport{
+			Id:       c.ID,
+			FirstSeq: c.FirstSeq,
+			LastSeq:  c.LastSeq,
+			LastHash: c.LastHash,
+		})
+	}
+	if report.Broken != nil {
+		resp.Message = fmt.Sprintf("Broken link at %s: %s", report.Broken.Position, report.Broken.Reason)
+		resp.Broken = &auditv1.BrokenLink{
+			Chain:    report.Broken.Chain,
+			Seq:      report.Broken.Seq,
+			Position: report.Broken.Position,
+			Reason:   report.Broken.Reason,
+		}
+	}
+	return resp, nil
+}
+
+// EraseSubject erases a data subject's actor and IP address from the store and its archives
+func (s *AuditServiceServer) EraseSubject(ctx context.Context, req *auditv1.EraseSubjectRequest) (*auditv1.EraseSubjectResponse, error) {
+	if s.lifecycle == nil {
+		return &auditv1.EraseSubjectResponse{Success: false, Message: "Erasure requires the audit.postgres store"}, nil
+	}
+
+	requester := "unknown"
+	if key, ok := auth.APIKeyFromContext(ctx); ok {
+		requester = key.Name
+	}
+	report, err := s.lifecycle.Erase(ctx, audit.Subject{Actor: req.Actor, IPAddress: req.IpAddress}, requester)
+	if err != nil {
+		return &auditv1.EraseSubjectResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &auditv1.EraseSubjectResponse{
+		Success:        true,
+		Message:        fmt.Sprintf("Erased %d events and %d archived events", report.Events, report.ArchivedEvents),
+		Events:         uint64(report.Events),
+		ArchivedEvents: uint64(report.ArchivedEvents),
+		ArchiveFiles:   report.Files,
+	}, nil
+}
+
+// Helper functions
+
+// logExport records who exported which events
+func (s *AuditServiceServer) logExport(ctx context.Context, req *auditv1.ExportEventsRequest, events uint64, err error, duration time.Duration) {
+	actor := "unknown"
+	if key, ok := auth.APIKeyFromContext(ctx); ok {
+		actor = key.Name
+	}
+	filter := req.GetFilter()
+	event := audit.AuditEvent{
+		Timestamp: time.Now(),
+		Action:    "audit_export",
+		Actor:     actor,
+		Resource:  "audit_events",
+		Success:   err == nil,
+		Duration:  duration,
+		Details: map[string]string{
+			"format":   req.Format,
+			"events":   fmt.Sprint(events),
+			"actor":    filter.GetActor(),
+			"action":   filter.GetAction(),
+			"resource": filter.GetResource(),
+		},
+	}
+	if err != nil {
+		event.Error = err.Error()
+	}
+	s.logger.LogEvent(event)
+}
+
+// auditFilterFromProto converts a proto filter; nil matches everything
+func auditFilterFromProto(filter *auditv1.EventFilter) audit.Filter {
+	if filter == nil {
+		return audit.Filter{}
+	}
+	f := audit.Filter{
+		Actor:    filter.Actor,
+		Action:   filter.Action,
+		Resource: filter.Resource,
+		Success:  filter.Success,
+		IPPrefix: filter.IpPrefix,
+	}
+	if filter.From != nil {
+		f.From = filter.From.AsTime()
+	}
+	if filter.To != nil {
+		f.To = filter.To.AsTime()
+	}
+	return f
+}
+
+// auditEventToProto converts a stored event
+func auditEventToProto(record audit.Record) *auditv1.Event {
+	event := record.Event
+	return &auditv1.Event{
+		Id:         record.ID,
+		Timestamp:  timestamppb.New(event.Timestamp),
+		Action:     event.Action,
+		Actor:      event.Actor,
+		Resource:   event.Resource,
+		Success:    event.Success,
+		Error:      event.Error,
+		Details:    event.Details,
+		IpAddress:  event.IPAddress,
+		UserAgent:  event.UserAgent,
+		DurationMs: event.Duration.Milliseconds(),
+		RequestId:  event.RequestID,
+		Chain:      event.Chain,
+		Seq:        event.Seq,
+		Hash:       event.Hash,
+	}
+}
+
+// store returns the named chain store, or the only one when source is empty
+func (s *AuditServiceServer) store(source string) (string, audit.ChainStore, error) {
+	stores := s.logger.Stores()
+	if store, ok := stores[source]; ok {
+		return source, store, nil
+	}
+	if source == "" && len(stores) == 1 {
+		for name, store := range stores {
+			return name, store, nil
+		}
+	}
+
+	names := make([]string, 0, len(stores))
+	for name := range stores {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	if len(names) == 0 {
+		return "", nil, fmt.Errorf("no file or postgres audit sink is enabled")
+	}
+	return "", nil, fmt.Errorf("source must be one of: %s", strings.Join(names, ", "))
+}
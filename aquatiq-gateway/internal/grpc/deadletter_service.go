@@ -0,0 +1,143 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	deadletterv1 "github.com/aquatiq/integration-gateway/api/proto/deadletter/v1"
+	"github.com/aquatiq/integration-gateway/internal/deadletter"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DeadLetterServiceServer implements the gRPC DeadLetterService
+type DeadLetterServiceServer struct {
+	deadletterv1.UnimplementedDeadLetterServiceServer
+	queue *deadletter.Queue
+}
+
+// NewDeadLetterServiceServer creates a new gRPC dead-letter service server
+func NewDeadLetterServiceServer(queue *deadletter.Queue) *DeadLetterServiceServer {
+	return &DeadLetterServiceServer{
+		queue: queue,
+	}
+}
+
+// ListEntries returns entries, newest first
+func (s *DeadLetterServiceServer) ListEntries(ctx context.Context, req *deadletterv1.ListEntriesRequest) (*deadletterv1.ListEntriesResponse, error) {
+	entries, err := s.queue.List(ctx, deadletter.Filter{
+		Service: req.Service,
+		Status:  req.Status,
+		Limit:   int(req.Limit),
+		Offset:  max(int(req.Offset), 0),
+	})
+	if err != nil {
+		return &deadletterv1.ListEntriesResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	protoEntries := make([]*deadletterv1.Entry, len(entries))
+	for i := range entries {
+		protoEntries[i] = deadLetterToProto(&entries[i])
+	}
+	return &deadletterv1.ListEntriesResponse{Success: true, Entries: protoEntries}, nil
+}
+
+// GetEntry returns one entry with its payload and attempt history
+func (s *DeadLetterServiceServer) GetEntry(ctx context.Context, req *deadletterv1.GetEntryRequest) (*deadletterv1.EntryResponse, error) {
+	entry, err := s.queue.Get(ctx, req.Id)
+	if err != nil {
+		return &deadletterv1.EntryResponse{Success: false, Message: err.Error()}, nil
+	}
+	return &deadletterv1.EntryResponse{Success: true, Entry: deadLetterToProto(entry)}, nil
+}
+
+// ReplayEntry sends an entry now; the entry status tells whether it went through
+func (s *DeadLetterServiceServer) ReplayEntry(ctx context.Context, req *deadletterv1.ReplayEntryRequest) (*deadletterv1.EntryResponse, error) {
+	entry, err := s.queue.Replay(ctx, req.Id)
+	if err != nil {
+		return &deadletterv1.EntryResponse{Success: false, Message: err.Error(), Entry: deadLetterToProto(entry)}, nil
+	}
+	if entry.Status != deadletter.StatusReplayed {
+		return &deadletterv1.EntryResponse{Success: false, Message: "Replay failed: " + entry.LastError, Entry: deadLetterToProto(entry)}, nil
+	}
+	return &deadletterv1.EntryResponse{Success: true, Message: "Entry replayed", Entry: deadLetterToProto(entry)}, nil
+}
+
+// EditEntry replaces the payload of a pending or failed entry
+func (s *DeadLetterServiceServer) EditEntry(ctx context.Context, req *deadletterv1.EditEntryRequest) (*deadletterv1.EntryResponse, error) {
+	entry, err := s.queue.Edit(ctx, req.Id, req.Payload, req.Note)
+	if err != nil {
+		return &deadletterv1.EntryResponse{Success: false, Message: err.Error(), Entry: deadLetterToProto(entry)}, nil
+	}
+	return &deadletterv1.EntryResponse{Success: true, Message: "Entry updated", Entry: deadLetterToProto(entry)}, nil
+}
+
+// DiscardEntry drops a pending or failed entry
+func (s *DeadLetterServiceServer) DiscardEntry(ctx context.Context, req *deadletterv1.DiscardEntryRequest) (*deadletterv1.EntryResponse, error) {
+	entry, err := s.queue.Discard(ctx, req.Id, req.Note)
+	if err != nil {
+		return &deadletterv1.EntryResponse{Success: false, Message: err.Error(), Entry: deadLetterToProto(entry)}, nil
+	}
+	return &deadletterv1.EntryResponse{Success: true, Message: "Entry discarded", Entry: deadLetterToProto(entry)}, nil
+}
+
+// GetStats returns entry counts per status
+func (s *DeadLetterServiceServer) GetStats(ctx context.Context, req *deadletterv1.GetStatsRequest) (*deadletterv1.StatsResponse, error) {
+	stats, err := s.queue.Stats(ctx)
+	if err != nil {
+		return &deadletterv1.StatsResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	counts := make(map[string]int32, len(stats.Counts))
+	for status, n := range stats.Counts {
+		counts[status] = int32(n)
+	}
+	return &deadletterv1.StatsResponse{
+		Success:  true,
+		Counts:   counts,
+		Captured: stats.Captured,
+		Replayed: stats.Replayed,
+		Retried:  stats.Retried,
+	}, nil
+}
+
+// Helper functions
+
+// deadLetterToProto converts a dead-letter entry to its protobuf message
+func deadLetterToProto(entry *deadletter.Entry) *deadletterv1.Entry {
+	if entry == nil {
+		return nil
+	}
+
+	headers := make(map[string]string, len(entry.Header))
+	for name, values := range entry.Header {
+		headers[name] = strings.Join(values, ", ")
+	}
+
+	attempts := make([]*deadletterv1.Attempt, len(entry.Attempts))
+	for i, a := range entry.Attempts {
+		attempts[i] = &deadletterv1.Attempt{
+			At:         timestamppb.New(a.At),
+			Trigger:    a.Trigger,
+			Success:    a.Success,
+			Error:      a.Error,
+			StatusCode: int32(a.StatusCode),
+		}
+	}
+
+	return &deadletterv1.Entry{
+		Id:            entry.ID,
+		Service:       entry.Service,
+		Method:        entry.Method,
+		Url:           entry.URL,
+		Headers:       headers,
+		Payload:       entry.Payload,
+		Status:        entry.Status,
+		Retries:       int32(entry.Retries),
+		LastError:     entry.LastError,
+		Note:          entry.Note,
+		Attempts:      attempts,
+		NextAttemptAt: optionalTimestamp(entry.NextAttemptAt),
+		CreatedAt:     timestamppb.New(entry.CreatedAt),
+		UpdatedAt:     timestamppb.New(entry.UpdatedAt),
+	}
+}
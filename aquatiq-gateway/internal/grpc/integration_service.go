@@ -0,0 +1,189 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	integrationv1 "github.com/aquatiq/integration-gateway/api/proto/integration/v1"
+	"github.com/aquatiq/integration-gateway/internal/auth"
+	"github.com/aquatiq/integration-gateway/internal/integrations/superoffice"
+	"github.com/aquatiq/integration-gateway/internal/integrations/visma"
+	"github.com/aquatiq/integration-gateway/pkg/circuitbreaker"
+	"github.com/aquatiq/integration-gateway/pkg/httpclient"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// testConnectionTimeout bounds a TestConnection call, including retries
+const testConnectionTimeout = 30 * time.Second
+
+// IntegrationServiceServer implements the gRPC IntegrationService
+type IntegrationServiceServer struct {
+	integrationv1.UnimplementedIntegrationServiceServer
+	superOffice *superoffice.Client
+	visma       *visma.Client
+	tokens      *auth.TokenManager
+	breakers    *circuitbreaker.Manager
+}
+
+// NewIntegrationServiceServer creates a new gRPC integration service server;
+// disabled integrations are passed as nil
+func NewIntegrationServiceServer(superOffice *superoffice.Client, visma *visma.Client, tokens *auth.TokenManager, breakers *circuitbreaker.Manager) *IntegrationServiceServer {
+	return &IntegrationServiceServer{
+		superOffice: superOffice,
+		visma:       visma,
+		tokens:      tokens,
+		breakers:    breakers,
+	}
+}
+
+// ListIntegrations returns the state of every configured integration
+func (s *IntegrationServiceServer) ListIntegrations(ctx context.Context, req *integrationv1.ListIntegrationsRequest) (*integrationv1.ListIntegrationsResponse, error) {
+	var list []*integrationv1.Integration
+	for _, name := range []string{superoffice.ServiceName, visma.ServiceName} {
+		if integration, ok := s.integration(name); ok {
+			list = append(list, integration)
+		}
+	}
+
+	return &integrationv1.ListIntegrationsResponse{
+		Success:      true,
+		Message:      fmt.Sprintf("%d integrations configured", len(list)),
+		Integrations: list,
+	}, nil
+}
+
+// GetIntegration returns the state of one integration
+func (s *IntegrationServiceServer) GetIntegration(ctx context.Context, req *integrationv1.GetIntegrationRequest) (*integrationv1.IntegrationResponse, error) {
+	integration, ok := s.integration(req.Name)
+	if !ok {
+		return &integrationv1.IntegrationResponse{Success: false, Message: "integration not configured: " + req.Name}, nil
+	}
+	return &integrationv1.IntegrationResponse{Success: true, Integration: integration}, nil
+}
+
+// TestConnection performs a cheap authenticated call against an integration.
+// The call goes through the integration's client, so it counts against its
+// quota and circuit breaker like any other call.
+func (s *IntegrationServiceServer) TestConnection(ctx context.Context, req *integrationv1.TestConnectionRequest) (*integrationv1.TestConnectionResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, testConnectionTimeout)
+	defer cancel()
+
+	var ping func(context.Context) error
+	var client interface{ Stats() httpclient.Stats }
+	switch {
+	case req.Name == superoffice.ServiceName && s.superOffice != nil:
+		ping, client = s.superOffice.Ping, s.superOffice
+	case req.Name == visma.ServiceName && s.visma != nil:
+		ping = func(ctx context.Context) error { return s.visma.Ping(ctx, req.Company) }
+		client = s.visma
+	default:
+		return &integrationv1.TestConnectionResponse{Success: false, Message: "integration not configured: " + req.Name}, nil
+	}
+
+	start := time.Now()
+	err := ping(ctx)
+	resp := &integrationv1.TestConnectionResponse{
+		Success:        err == nil,
+		Message:        "Connection OK",
+		LatencyMs:      time.Since(start).Milliseconds(),
+		CircuitBreaker: client.Stats().Breaker,
+	}
+	if err != nil {
+		resp.Message = err.Error()
+		resp.StatusCode = int32(upstreamStatus(err))
+	}
+	return resp, nil
+}
+
+// integration collects the state of a configured integration
+func (s *IntegrationServiceServer) integration(name string) (*integrationv1.Integration, bool) {
+	var stats httpclient.Stats
+	var baseURL string
+	switch {
+	case name == superoffice.ServiceName && s.superOffice != nil:
+		stats, baseURL = s.superOffice.Stats(), s.superOffice.BaseURL()
+	case name == visma.ServiceName && s.visma != nil:
+		stats, baseURL = s.visma.Stats(), s.visma.BaseURL()
+	default:
+		return nil, false
+	}
+
+	integration := &integrationv1.Integration{
+		Name:     name,
+		BaseUrl:  baseURL,
+		AuthType: stats.Auth.Type,
+		CircuitBreaker: &integrationv1.CircuitBreakerState{
+			State: stats.Breaker,
+		},
+		Calls: &integrationv1.CallHealth{
+			WindowSeconds: int32(stats.Calls.WindowSeconds),
+			Calls:         stats.Calls.Calls,
+			Failures:      stats.Calls.Failures,
+			ErrorRate:     stats.Calls.ErrorRate,
+			LastSuccess:   optionalTimestamp(stats.Calls.LastSuccess),
+			LastFailure:   optionalTimestamp(stats.Calls.LastFailure),
+			LastError:     stats.Calls.LastError,
+		},
+	}
+
+	if s.breakers != nil {
+		if cb, err := s.breakers.Get(name); err == nil {
+			counts := cb.Counts()
+			integration.CircuitBreaker.Requests = counts.Requests
+			integration.CircuitBreaker.TotalFailures = counts.TotalFailures
+			integration.CircuitBreaker.ConsecutiveFailures = counts.ConsecutiveFailures
+		}
+	}
+
+	if stats.Auth.Type == "oauth2" && s.tokens != nil {
+		status := s.tokens.Status(name)
+		integration.Token = &integrationv1.TokenState{
+			HasToken:    status.HasToken,
+			ExpiresAt:   optionalTimestamp(status.ExpiresAt),
+			LastRefresh: optionalTimestamp(status.LastRefresh),
+			LastError:   status.LastError,
+		}
+	}
+
+	for _, q := range stats.RateLimit.Quotas {
+		remaining := make(map[string]int32, len(q.Remaining))
+		for key, n := range q.Remaining {
+			remaining[key] = int32(n)
+		}
+		integration.Quotas = append(integration.Quotas, &integrationv1.Quota{
+			Name:          q.Name,
+			Scope:         q.Scope,
+			Limit:         int32(q.Limit),
+			WindowSeconds: int32(q.WindowSeconds),
+			Remaining:     remaining,
+		})
+	}
+
+	return integration, true
+}
+
+// Helper functions
+
+// optionalTimestamp converts a time, leaving zero times unset
+func optionalTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// upstreamStatus returns the HTTP status of a provider error, or 0 when the
+// provider never answered
+func upstreamStatus(err error) int {
+	var statusErr *httpclient.StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode
+	}
+	var vismaErr *visma.Error
+	if errors.As(err, &vismaErr) {
+		return vismaErr.StatusCode
+	}
+	return 0
+}
@@ -0,0 +1,494 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	superofficev1 "github.com/aquatiq/integration-gateway/api/proto/superoffice/v1"
+	"github.com/aquatiq/integration-gateway/internal/integrations/superoffice"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SuperOfficeServiceServer implements the gRPC SuperOfficeService
+type SuperOfficeServiceServer struct {
+	superofficev1.UnimplementedSuperOfficeServiceServer
+	client *superoffice.Client
+}
+
+// NewSuperOfficeServiceServer creates a new gRPC SuperOffice service server
+func NewSuperOfficeServiceServer(client *superoffice.Client) *SuperOfficeServiceServer {
+	return &SuperOfficeServiceServer{
+		client: client,
+	}
+}
+
+// ListContacts returns contacts matching an OData query
+func (s *SuperOfficeServiceServer) ListContacts(ctx context.Context, req *superofficev1.ListRequest) (*superofficev1.ListContactsResponse, error) {
+	page, err := listPage(ctx, s.client.Contacts, req)
+	if err != nil {
+		return &superofficev1.ListContactsResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	contacts := make([]*superofficev1.Contact, len(page.Items))
+	for i := range page.Items {
+		contacts[i] = contactToProto(&page.Items[i])
+	}
+	return &superofficev1.ListContactsResponse{
+		Success:       true,
+		Contacts:      contacts,
+		NextPageToken: page.NextLink,
+	}, nil
+}
+
+// GetContact returns a contact by ID
+func (s *SuperOfficeServiceServer) GetContact(ctx context.Context, req *superofficev1.GetRequest) (*superofficev1.ContactResponse, error) {
+	contact, err := s.client.Contacts.Get(ctx, int(req.Id))
+	if err != nil {
+		return &superofficev1.ContactResponse{Success: false, Message: err.Error()}, nil
+	}
+	return &superofficev1.ContactResponse{Success: true, Contact: contactToProto(contact)}, nil
+}
+
+// CreateContact creates a contact
+func (s *SuperOfficeServiceServer) CreateContact(ctx context.Context, req *superofficev1.CreateContactRequest) (*superofficev1.ContactResponse, error) {
+	if req.Contact == nil {
+		return &superofficev1.ContactResponse{Success: false, Message: "contact is required"}, nil
+	}
+
+	contact, err := s.client.Contacts.Create(ctx, contactFromProto(req.Contact))
+	if err != nil {
+		return &superofficev1.ContactResponse{Success: false, Message: err.Error()}, nil
+	}
+	return &superofficev1.ContactResponse{Success: true, Message: "Contact created successfully", Contact: contactToProto(contact)}, nil
+}
+
+// UpdateContact updates a contact
+func (s *SuperOfficeServiceServer) UpdateContact(ctx context.Context, req *superofficev1.UpdateContactRequest) (*superofficev1.ContactResponse, error) {
+	if req.Contact == nil || req.Contact.ContactId == 0 {
+		return &superofficev1.ContactResponse{Success: false, Message: "contact_id is required"}, nil
+	}
+
+	contact, err := s.client.Contacts.Update(ctx, int(req.Contact.ContactId), contactFromProto(req.Contact))
+	if err != nil {
+		return &superofficev1.ContactResponse{Success: false, Message: err.Error()}, nil
+	}
+	return &superofficev1.ContactResponse{Success: true, Message: "Contact updated successfully", Contact: contactToProto(contact)}, nil
+}
+
+// ListPersons returns persons matching an OData query
+func (s *SuperOfficeServiceServer) ListPersons(ctx context.Context, req *superofficev1.ListRequest) (*superofficev1.ListPersonsResponse, error) {
+	page, err := listPage(ctx, s.client.Persons, req)
+	if err != nil {
+		return &superofficev1.ListPersonsResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	persons := make([]*superofficev1.Person, len(page.Items))
+	for i := range page.Items {
+		persons[i] = personToProto(&page.Items[i])
+	}
+	return &superofficev1.ListPersonsResponse{
+		Success:       true,
+		Persons:       persons,
+		NextPageToken: page.NextLink,
+	}, nil
+}
+
+// GetPerson returns a person by ID
+func (s *SuperOfficeServiceServer) GetPerson(ctx context.Context, req *superofficev1.GetRequest) (*superofficev1.PersonResponse, error) {
+	person, err := s.client.Persons.Get(ctx, int(req.Id))
+	if err != nil {
+		return &superofficev1.PersonResponse{Success: false, Message: err.Error()}, nil
+	}
+	return &superofficev1.PersonResponse{Success: true, Person: personToProto(person)}, nil
+}
+
+// CreatePerson creates a person
+func (s *SuperOfficeServiceServer) CreatePerson(ctx context.Context, req *superofficev1.CreatePersonRequest) (*superofficev1.PersonResponse, error) {
+	if req.Person == nil {
+		return &superofficev1.PersonResponse{Success: false, Message: "person is required"}, nil
+	}
+
+	person, err := s.client.Persons.Create(ctx, personFromProto(req.Person))
+	if err != nil {
+		return &superofficev1.PersonResponse{Success: false, Message: err.Error()}, nil
+	}
+	return &superofficev1.PersonResponse{Success: true, Message: "Person created successfully", Person: personToProto(person)}, nil
+}
+
+// UpdatePerson updates a person
+func (s *SuperOfficeServiceServer) UpdatePerson(ctx context.Context, req *superofficev1.UpdatePersonRequest) (*superofficev1.PersonResponse, error) {
+	if req.Person == nil || req.Person.PersonId == 0 {
+		return &superofficev1.PersonResponse{Success: false, Message: "person_id is required"}, nil
+	}
+
+	person, err := s.client.Persons.Update(ctx, int(req.Person.PersonId), personFromProto(req.Person))
+	if err != nil {
+		return &superofficev1.PersonResponse{Success: false, Message: err.Error()}, nil
+	}
+	return &superofficev1.PersonResponse{Success: true, Message: "Person updated successfully", Person: personToProto(person)}, nil
+}
+
+// ListSales returns sales matching an OData query
+func (s *SuperOfficeServiceServer) ListSales(ctx context.Context, req *superofficev1.ListRequest) (*superofficev1.ListSalesResponse, error) {
+	page, err := listPage(ctx, s.client.Sales, req)
+	if err != nil {
+		return &superofficev1.ListSalesResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	sales := make([]*superofficev1.Sale, len(page.Items))
+	for i := range page.Items {
+		sales[i] = saleToProto(&page.Items[i])
+	}
+	return &superofficev1.ListSalesResponse{
+		Success:       true,
+		Sales:         sales,
+		NextPageToken: page.NextLink,
+	}, nil
+}
+
+// GetSale returns a sale by ID
+func (s *SuperOfficeServiceServer) GetSale(ctx context.Context, req *superofficev1.GetRequest) (*superofficev1.SaleResponse, error) {
+	sale, err := s.client.Sales.Get(ctx, int(req.Id))
+	if err != nil {
+		return &superofficev1.SaleResponse{Success: false, Message: err.Error()}, nil
+	}
+	return &superofficev1.SaleResponse{Success: true, Sale: saleToProto(sale)}, nil
+}
+
+// CreateSale creates a sale
+func (s *SuperOfficeServiceServer) CreateSale(ctx context.Context, req *superofficev1.CreateSaleRequest) (*superofficev1.SaleResponse, error) {
+	if req.Sale == nil {
+		return &superofficev1.SaleResponse{Success: false, Message: "sale is required"}, nil
+	}
+
+	sale, err := s.client.Sales.Create(ctx, saleFromProto(req.Sale))
+	if err != nil {
+		return &superofficev1.SaleResponse{Success: false, Message: err.Error()}, nil
+	}
+	return &superofficev1.SaleResponse{Success: true, Message: "Sale created successfully", Sale: saleToProto(sale)}, nil
+}
+
+// UpdateSale updates a sale
+func (s *SuperOfficeServiceServer) UpdateSale(ctx context.Context, req *superofficev1.UpdateSaleRequest) (*superofficev1.SaleResponse, error) {
+	if req.Sale == nil || req.Sale.SaleId == 0 {
+		return &superofficev1.SaleResponse{Success: false, Message: "sale_id is required"}, nil
+	}
+
+	sale, err := s.client.Sales.Update(ctx, int(req.Sale.SaleId), saleFromProto(req.Sale))
+	if err != nil {
+		return &superofficev1.SaleResponse{Success: false, Message: err.Error()}, nil
+	}
+	return &superofficev1.SaleResponse{Success: true, Message: "Sale updated successfully", Sale: saleToProto(sale)}, nil
+}
+
+// ListProjects returns projects matching an OData query
+func (s *SuperOfficeServiceServer) ListProjects(ctx context.Context, req *superofficev1.ListRequest) (*superofficev1.ListProjectsResponse, error) {
+	page, err := listPage(ctx, s.client.Projects, req)
+	if err != nil {
+		return &superofficev1.ListProjectsResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	projects := make([]*superofficev1.Project, len(page.Items))
+	for i := range page.Items {
+		projects[i] = projectToProto(&page.Items[i])
+	}
+	return &superofficev1.ListProjectsResponse{
+		Success:       true,
+		Projects:      projects,
+		NextPageToken: page.NextLink,
+	}, nil
+}
+
+// GetProject returns a project by ID
+func (s *SuperOfficeServiceServer) GetProject(ctx context.Context, req *superofficev1.GetRequest) (*superofficev1.ProjectResponse, error) {
+	project, err := s.client.Projects.Get(ctx, int(req.Id))
+	if err != nil {
+		return &superofficev1.ProjectResponse{Success: false, Message: err.Error()}, nil
+	}
+	return &superofficev1.ProjectResponse{Success: true, Project: projectToProto(project)}, nil
+}
+
+// ListAppointments returns appointments matching an OData query
+func (s *SuperOfficeServiceServer) ListAppointments(ctx context.Context, req *superofficev1.ListRequest) (*superofficev1.ListAppointmentsResponse, error) {
+	page, err := listPage(ctx, s.client.Appointments, req)
+	if err != nil {
+		return &superofficev1.ListAppointmentsResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	appointments := make([]*superofficev1.Appointment, len(page.Items))
+	for i := range page.Items {
+		appointments[i] = appointmentToProto(&page.Items[i])
+	}
+	return &superofficev1.ListAppointmentsResponse{
+		Success:       true,
+		Appointments:  appointments,
+		NextPageToken: page.NextLink,
+	}, nil
+}
+
+// GetAppointment returns an appointment by ID
+func (s *SuperOfficeServiceServer) GetAppointment(ctx context.Context, req *superofficev1.GetRequest) (*superofficev1.AppointmentResponse, error) {
+	appointment, err := s.client.Appointments.Get(ctx, int(req.Id))
+	if err != nil {
+		return &superofficev1.AppointmentResponse{Success: false, Message: err.Error()}, nil
+	}
+	return &superofficev1.AppointmentResponse{Success: true, Appointment: appointmentToProto(appointment)}, nil
+}
+
+// CreateAppointment creates an appointment
+func (s *SuperOfficeServiceServer) CreateAppointment(ctx context.Context, req *superofficev1.CreateAppointmentRequest) (*superofficev1.AppointmentResponse, error) {
+	if req.Appointment == nil {
+		return &superofficev1.AppointmentResponse{Success: false, Message: "appointment is required"}, nil
+	}
+
+	appointment, err := s.client.Appointments.Create(ctx, appointmentFromProto(req.Appointment))
+	if err != nil {
+		return &superofficev1.AppointmentResponse{Success: false, Message: err.Error()}, nil
+	}
+	return &superofficev1.AppointmentResponse{Success: true, Message: "Appointment created successfully", Appointment: appointmentToProto(appointment)}, nil
+}
+
+// Helper functions
+
+// listPage fetches the requested page, continuing from a page token if given
+func listPage[T any](ctx context.Context, resource *superoffice.Resource[T], req *superofficev1.ListRequest) (*superoffice.Page[T], error) {
+	if req.PageToken != "" {
+		return resource.Next(ctx, req.PageToken)
+	}
+	return resource.List(ctx, superoffice.Query{
+		Filter:  req.Filter,
+		Select:  req.Select,
+		OrderBy: req.OrderBy,
+		Top:     int(req.PageSize),
+		Skip:    int(req.Skip),
+	})
+}
+
+// soTimestamp converts a SuperOffice date to a protobuf timestamp
+func soTimestamp(d superoffice.DateTime) *timestamppb.Timestamp {
+	if d.IsZero() {
+		return nil
+	}
+	return timestamppb.New(d.Time)
+}
+
+// soDateTime converts a protobuf timestamp to a SuperOffice date
+func soDateTime(ts *timestamppb.Timestamp) superoffice.DateTime {
+	if ts == nil {
+		return superoffice.DateTime{}
+	}
+	return superoffice.DateTime{Time: ts.AsTime()}
+}
+
+// elementValues returns the values of entity elements
+func elementValues(elements []superoffice.EntityElement) []string {
+	values := make([]string, 0, len(elements))
+	for _, e := range elements {
+		values = append(values, e.Value)
+	}
+	return values
+}
+
+// elementsFromValues builds entity elements from values
+func elementsFromValues(values []string) []superoffice.EntityElement {
+	if len(values) == 0 {
+		return nil
+	}
+	elements := make([]superoffice.EntityElement, len(values))
+	for i, v := range values {
+		elements[i] = superoffice.EntityElement{Value: v}
+	}
+	return elements
+}
+
+// contactID returns the ID of a contact reference
+func contactID(ref *superoffice.ContactRef) int32 {
+	if ref == nil {
+		return 0
+	}
+	return int32(ref.ContactID)
+}
+
+// personID returns the ID of a person reference
+func personID(ref *superoffice.PersonRef) int32 {
+	if ref == nil {
+		return 0
+	}
+	return int32(ref.PersonID)
+}
+
+// contactRef builds a contact reference from an ID
+func contactRef(id int32) *superoffice.ContactRef {
+	if id == 0 {
+		return nil
+	}
+	return &superoffice.ContactRef{ContactID: int(id)}
+}
+
+// personRef builds a person reference from an ID
+func personRef(id int32) *superoffice.PersonRef {
+	if id == 0 {
+		return nil
+	}
+	return &superoffice.PersonRef{PersonID: int(id)}
+}
+
+// contactToProto converts a contact to protobuf
+func contactToProto(c *superoffice.Contact) *superofficev1.Contact {
+	contact := &superofficev1.Contact{
+		ContactId:  int32(c.ContactID),
+		Name:       c.Name,
+		Department: c.Department,
+		OrgNr:      c.OrgNr,
+		Number:     c.Number,
+		Phones:     elementValues(c.Phones),
+		Emails:     elementValues(c.Emails),
+		CountryId:  int32(c.CountryID),
+		CreatedAt:  soTimestamp(c.CreatedDate),
+		UpdatedAt:  soTimestamp(c.UpdatedDate),
+	}
+	if c.Address != nil {
+		contact.PostalAddress = &superofficev1.Address{
+			Address1: c.Address.Postal.Address1,
+			Address2: c.Address.Postal.Address2,
+			Zipcode:  c.Address.Postal.Zipcode,
+			City:     c.Address.Postal.City,
+		}
+	}
+	return contact
+}
+
+// contactFromProto converts a protobuf contact
+func contactFromProto(c *superofficev1.Contact) *superoffice.Contact {
+	contact := &superoffice.Contact{
+		ContactID:  int(c.ContactId),
+		Name:       c.Name,
+		Department: c.Department,
+		OrgNr:      c.OrgNr,
+		Number:     c.Number,
+		Phones:     elementsFromValues(c.Phones),
+		Emails:     elementsFromValues(c.Emails),
+		CountryID:  int(c.CountryId),
+	}
+	if a := c.PostalAddress; a != nil {
+		contact.Address = &superoffice.ContactAddress{
+			Postal: superoffice.Address{
+				Address1: a.Address1,
+				Address2: a.Address2,
+				Zipcode:  a.Zipcode,
+				City:     a.City,
+			},
+		}
+	}
+	return contact
+}
+
+// personToProto converts a person to protobuf
+func personToProto(p *superoffice.Person) *superofficev1.Person {
+	return &superofficev1.Person{
+		PersonId:     int32(p.PersonID),
+		Firstname:    p.Firstname,
+		Lastname:     p.Lastname,
+		Title:        p.Title,
+		ContactId:    contactID(p.Contact),
+		Emails:       elementValues(p.Emails),
+		OfficePhones: elementValues(p.OfficePhones),
+		MobilePhones: elementValues(p.MobilePhones),
+		CreatedAt:    soTimestamp(p.CreatedDate),
+		UpdatedAt:    soTimestamp(p.UpdatedDate),
+	}
+}
+
+// personFromProto converts a protobuf person
+func personFromProto(p *superofficev1.Person) *superoffice.Person {
+	return &superoffice.Person{
+		PersonID:     int(p.PersonId),
+		Firstname:    p.Firstname,
+		Lastname:     p.Lastname,
+		Title:        p.Title,
+		Contact:      contactRef(p.ContactId),
+		Emails:       elementsFromValues(p.Emails),
+		OfficePhones: elementsFromValues(p.OfficePhones),
+		MobilePhones: elementsFromValues(p.MobilePhones),
+	}
+}
+
+// saleToProto converts a sale to protobuf
+func saleToProto(s *superoffice.Sale) *superofficev1.Sale {
+	return &superofficev1.Sale{
+		SaleId:      int32(s.SaleID),
+		Heading:     s.Heading,
+		Description: s.Description,
+		Amount:      s.Amount,
+		Currency:    s.Currency,
+		Probability: int32(s.Probability),
+		Status:      s.Status,
+		SaleDate:    soTimestamp(s.SaleDate),
+		ContactId:   contactID(s.Contact),
+		PersonId:    personID(s.Person),
+		CreatedAt:   soTimestamp(s.CreatedDate),
+		UpdatedAt:   soTimestamp(s.UpdatedDate),
+	}
+}
+
+// saleFromProto converts a protobuf sale
+func saleFromProto(s *superofficev1.Sale) *superoffice.Sale {
+	return &superoffice.Sale{
+		SaleID:      int(s.SaleId),
+		Heading:     s.Heading,
+		Description: s.Description,
+		Amount:      s.Amount,
+		Currency:    s.Currency,
+		Probability: int(s.Probability),
+		Status:      s.Status,
+		SaleDate:    soDateTime(s.SaleDate),
+		Contact:     contactRef(s.ContactId),
+		Person:      personRef(s.PersonId),
+	}
+}
+
+// projectToProto converts a project to protobuf
+func projectToProto(p *superoffice.Project) *superofficev1.Project {
+	return &superofficev1.Project{
+		ProjectId:     int32(p.ProjectID),
+		Name:          p.Name,
+		ProjectNumber: p.ProjectNumber,
+		Description:   p.Description,
+		Completed:     p.Completed,
+		EndDate:       soTimestamp(p.EndDate),
+		CreatedAt:     soTimestamp(p.CreatedDate),
+		UpdatedAt:     soTimestamp(p.UpdatedDate),
+	}
+}
+
+// appointmentToProto converts an appointment to protobuf
+func appointmentToProto(a *superoffice.Appointment) *superofficev1.Appointment {
+	return &superofficev1.Appointment{
+		AppointmentId: int32(a.AppointmentID),
+		Description:   a.Description,
+		Location:      a.Location,
+		StartDate:     soTimestamp(a.StartDate),
+		EndDate:       soTimestamp(a.EndDate),
+		ContactId:     contactID(a.Contact),
+		PersonId:      personID(a.Person),
+		CreatedAt:     soTimestamp(a.CreatedDate),
+		UpdatedAt:     soTimestamp(a.UpdatedDate),
+	}
+}
+
+// appointmentFromProto converts a protobuf appointment
+func appointmentFromProto(a *superofficev1.Appointment) *superoffice.Appointment {
+	appointment := &superoffice.Appointment{
+		AppointmentID: int(a.AppointmentId),
+		Description:   a.Description,
+		Location:      a.Location,
+		StartDate:     soDateTime(a.StartDate),
+		EndDate:       soDateTime(a.EndDate),
+		Contact:       contactRef(a.ContactId),
+		Person:        personRef(a.PersonId),
+	}
+	if appointment.EndDate.IsZero() && !appointment.StartDate.IsZero() {
+		appointment.EndDate = superoffice.DateTime{Time: appointment.StartDate.Add(time.Hour)}
+	}
+	return appointment
+}
@@ -0,0 +1,107 @@
+package grpc
+
+import (
+	"context"
+
+	syncv1 "github.com/aquatiq/integration-gateway/api/proto/sync/v1"
+	"github.com/aquatiq/integration-gateway/internal/customersync"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SyncServiceServer implements the gRPC SyncService
+type SyncServiceServer struct {
+	syncv1.UnimplementedSyncServiceServer
+	engine *customersync.Engine
+}
+
+// NewSyncServiceServer creates a new gRPC customer sync service server
+func NewSyncServiceServer(engine *customersync.Engine) *SyncServiceServer {
+	return &SyncServiceServer{
+		engine: engine,
+	}
+}
+
+// RunSync starts a sync run and returns its result when it finishes
+func (s *SyncServiceServer) RunSync(ctx context.Context, req *syncv1.RunSyncRequest) (*syncv1.RunResponse, error) {
+	run, err := s.engine.Run(ctx, customersync.RunOptions{
+		Full:    req.Full,
+		DryRun:  req.DryRun,
+		Trigger: "grpc",
+	})
+	if err != nil {
+		// A failed run is still returned so callers can see how far it got
+		return &syncv1.RunResponse{Success: false, Message: err.Error(), Run: syncRunToProto(run)}, nil
+	}
+	return &syncv1.RunResponse{Success: true, Message: "Sync run " + run.Status, Run: syncRunToProto(run)}, nil
+}
+
+// GetRun returns a recorded run
+func (s *SyncServiceServer) GetRun(ctx context.Context, req *syncv1.GetRunRequest) (*syncv1.RunResponse, error) {
+	run, err := s.engine.Store().Run(ctx, req.Id)
+	if err != nil {
+		return &syncv1.RunResponse{Success: false, Message: err.Error()}, nil
+	}
+	return &syncv1.RunResponse{Success: true, Run: syncRunToProto(run)}, nil
+}
+
+// ListRuns returns the most recent runs, newest first
+func (s *SyncServiceServer) ListRuns(ctx context.Context, req *syncv1.ListRunsRequest) (*syncv1.ListRunsResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 || limit > 500 {
+		limit = 20
+	}
+
+	runs, err := s.engine.Store().Runs(ctx, limit)
+	if err != nil {
+		return &syncv1.ListRunsResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	protoRuns := make([]*syncv1.Run, len(runs))
+	for i := range runs {
+		protoRuns[i] = syncRunToProto(&runs[i])
+	}
+	return &syncv1.ListRunsResponse{Success: true, Runs: protoRuns}, nil
+}
+
+// Helper functions
+
+// syncRunToProto converts a sync run result to its protobuf message
+func syncRunToProto(run *customersync.RunResult) *syncv1.Run {
+	if run == nil {
+		return nil
+	}
+
+	items := make([]*syncv1.ItemResult, len(run.Items))
+	for i, item := range run.Items {
+		items[i] = &syncv1.ItemResult{
+			SuperofficeContactId: int32(item.SuperOfficeID),
+			VismaCustomerNumber:  item.VismaNumber,
+			Action:               item.Action,
+			Winner:               item.Winner,
+			Message:              item.Message,
+		}
+	}
+
+	r := &syncv1.Run{
+		Id:                   run.ID,
+		Trigger:              run.Trigger,
+		DryRun:               run.DryRun,
+		Full:                 run.Full,
+		Status:               run.Status,
+		StartedAt:            timestamppb.New(run.StartedAt),
+		CreatedInVisma:       int32(run.CreatedInVisma),
+		UpdatedInVisma:       int32(run.UpdatedInVisma),
+		CreatedInSuperoffice: int32(run.CreatedInSuperOffice),
+		UpdatedInSuperoffice: int32(run.UpdatedInSuperOffice),
+		Unchanged:            int32(run.Unchanged),
+		Skipped:              int32(run.Skipped),
+		Conflicts:            int32(run.Conflicts),
+		Failed:               int32(run.Failed),
+		Error:                run.Error,
+		Items:                items,
+	}
+	if !run.FinishedAt.IsZero() {
+		r.FinishedAt = timestamppb.New(run.FinishedAt)
+	}
+	return r
+}
@@ -0,0 +1,429 @@
+package grpc
+
+import (
+	"context"
+
+	vismav1 "github.com/aquatiq/integration-gateway/api/proto/visma/v1"
+	"github.com/aquatiq/integration-gateway/internal/integrations/visma"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// VismaServiceServer implements the gRPC VismaService
+type VismaServiceServer struct {
+	vismav1.UnimplementedVismaServiceServer
+	client *visma.Client
+}
+
+// NewVismaServiceServer creates a new gRPC Visma service server
+func NewVismaServiceServer(client *visma.Client) *VismaServiceServer {
+	return &VismaServiceServer{
+		client: client,
+	}
+}
+
+// ListCustomers returns a page of customers
+func (s *VismaServiceServer) ListCustomers(ctx context.Context, req *vismav1.ListRequest) (*vismav1.ListCustomersResponse, error) {
+	page, err := s.client.Customers.List(ctx, req.CompanyId, vismaListOptions(req))
+	if err != nil {
+		return &vismav1.ListCustomersResponse{Success: false, Message: err.Error(), ErrorCode: visma.ErrorCode(err)}, nil
+	}
+
+	customers := make([]*vismav1.Customer, len(page.Items))
+	for i := range page.Items {
+		customers[i] = customerToProto(&page.Items[i])
+	}
+	return &vismav1.ListCustomersResponse{Success: true, Customers: customers, HasMore: page.HasMore}, nil
+}
+
+// GetCustomer returns a customer by number
+func (s *VismaServiceServer) GetCustomer(ctx context.Context, req *vismav1.GetRequest) (*vismav1.CustomerResponse, error) {
+	customer, err := s.client.Customers.Get(ctx, req.CompanyId, req.Key)
+	if err != nil {
+		return &vismav1.CustomerResponse{Success: false, Message: err.Error(), ErrorCode: visma.ErrorCode(err)}, nil
+	}
+	return &vismav1.CustomerResponse{Success: true, Customer: customerToProto(customer)}, nil
+}
+
+// CreateCustomer creates a customer
+func (s *VismaServiceServer) CreateCustomer(ctx context.Context, req *vismav1.CreateCustomerRequest) (*vismav1.CustomerResponse, error) {
+	if req.Customer == nil {
+		return &vismav1.CustomerResponse{Success: false, Message: "customer is required", ErrorCode: "validation_failed"}, nil
+	}
+
+	customer, err := s.client.Customers.Create(ctx, req.CompanyId, customerFromProto(req.Customer))
+	if err != nil {
+		return &vismav1.CustomerResponse{Success: false, Message: err.Error(), ErrorCode: visma.ErrorCode(err)}, nil
+	}
+	return &vismav1.CustomerResponse{Success: true, Message: "Customer created successfully", Customer: customerToProto(customer)}, nil
+}
+
+// UpdateCustomer updates a customer
+func (s *VismaServiceServer) UpdateCustomer(ctx context.Context, req *vismav1.UpdateCustomerRequest) (*vismav1.CustomerResponse, error) {
+	if req.Customer == nil || req.Customer.Number == "" {
+		return &vismav1.CustomerResponse{Success: false, Message: "customer number is required", ErrorCode: "validation_failed"}, nil
+	}
+
+	customer, err := s.client.Customers.Update(ctx, req.CompanyId, req.Customer.Number, customerFromProto(req.Customer))
+	if err != nil {
+		return &vismav1.CustomerResponse{Success: false, Message: err.Error(), ErrorCode: visma.ErrorCode(err)}, nil
+	}
+	return &vismav1.CustomerResponse{Success: true, Message: "Customer updated successfully", Customer: customerToProto(customer)}, nil
+}
+
+// ListSuppliers returns a page of suppliers
+func (s *VismaServiceServer) ListSuppliers(ctx context.Context, req *vismav1.ListRequest) (*vismav1.ListSuppliersResponse, error) {
+	page, err := s.client.Suppliers.List(ctx, req.CompanyId, vismaListOptions(req))
+	if err != nil {
+		return &vismav1.ListSuppliersResponse{Success: false, Message: err.Error(), ErrorCode: visma.ErrorCode(err)}, nil
+	}
+
+	suppliers := make([]*vismav1.Supplier, len(page.Items))
+	for i := range page.Items {
+		suppliers[i] = supplierToProto(&page.Items[i])
+	}
+	return &vismav1.ListSuppliersResponse{Success: true, Suppliers: suppliers, HasMore: page.HasMore}, nil
+}
+
+// GetSupplier returns a supplier by number
+func (s *VismaServiceServer) GetSupplier(ctx context.Context, req *vismav1.GetRequest) (*vismav1.SupplierResponse, error) {
+	supplier, err := s.client.Suppliers.Get(ctx, req.CompanyId, req.Key)
+	if err != nil {
+		return &vismav1.SupplierResponse{Success: false, Message: err.Error(), ErrorCode: visma.ErrorCode(err)}, nil
+	}
+	return &vismav1.SupplierResponse{Success: true, Supplier: supplierToProto(supplier)}, nil
+}
+
+// CreateSupplier creates a supplier
+func (s *VismaServiceServer) CreateSupplier(ctx context.Context, req *vismav1.CreateSupplierRequest) (*vismav1.SupplierResponse, error) {
+	if req.Supplier == nil {
+		return &vismav1.SupplierResponse{Success: false, Message: "supplier is required", ErrorCode: "validation_failed"}, nil
+	}
+
+	supplier, err := s.client.Suppliers.Create(ctx, req.CompanyId, supplierFromProto(req.Supplier))
+	if err != nil {
+		return &vismav1.SupplierResponse{Success: false, Message: err.Error(), ErrorCode: visma.ErrorCode(err)}, nil
+	}
+	return &vismav1.SupplierResponse{Success: true, Message: "Supplier created successfully", Supplier: supplierToProto(supplier)}, nil
+}
+
+// UpdateSupplier updates a supplier
+func (s *VismaServiceServer) UpdateSupplier(ctx context.Context, req *vismav1.UpdateSupplierRequest) (*vismav1.SupplierResponse, error) {
+	if req.Supplier == nil || req.Supplier.Number == "" {
+		return &vismav1.SupplierResponse{Success: false, Message: "supplier number is required", ErrorCode: "validation_failed"}, nil
+	}
+
+	supplier, err := s.client.Suppliers.Update(ctx, req.CompanyId, req.Supplier.Number, supplierFromProto(req.Supplier))
+	if err != nil {
+		return &vismav1.SupplierResponse{Success: false, Message: err.Error(), ErrorCode: visma.ErrorCode(err)}, nil
+	}
+	return &vismav1.SupplierResponse{Success: true, Message: "Supplier updated successfully", Supplier: supplierToProto(supplier)}, nil
+}
+
+// ListSalesInvoices returns a page of sales invoices
+func (s *VismaServiceServer) ListSalesInvoices(ctx context.Context, req *vismav1.ListRequest) (*vismav1.ListSalesInvoicesResponse, error) {
+	page, err := s.client.SalesInvoices.List(ctx, req.CompanyId, vismaListOptions(req))
+	if err != nil {
+		return &vismav1.ListSalesInvoicesResponse{Success: false, Message: err.Error(), ErrorCode: visma.ErrorCode(err)}, nil
+	}
+
+	invoices := make([]*vismav1.SalesInvoice, len(page.Items))
+	for i := range page.Items {
+		invoices[i] = salesInvoiceToProto(&page.Items[i])
+	}
+	return &vismav1.ListSalesInvoicesResponse{Success: true, Invoices: invoices, HasMore: page.HasMore}, nil
+}
+
+// GetSalesInvoice returns a sales invoice by reference number
+func (s *VismaServiceServer) GetSalesInvoice(ctx context.Context, req *vismav1.GetRequest) (*vismav1.SalesInvoiceResponse, error) {
+	invoice, err := s.client.SalesInvoices.Get(ctx, req.CompanyId, req.Key)
+	if err != nil {
+		return &vismav1.SalesInvoiceResponse{Success: false, Message: err.Error(), ErrorCode: visma.ErrorCode(err)}, nil
+	}
+	return &vismav1.SalesInvoiceResponse{Success: true, Invoice: salesInvoiceToProto(invoice)}, nil
+}
+
+// CreateSalesInvoice creates a sales invoice
+func (s *VismaServiceServer) CreateSalesInvoice(ctx context.Context, req *vismav1.CreateSalesInvoiceRequest) (*vismav1.SalesInvoiceResponse, error) {
+	if req.Invoice == nil || req.Invoice.CustomerNumber == "" {
+		return &vismav1.SalesInvoiceResponse{Success: false, Message: "invoice with customer_number is required", ErrorCode: "validation_failed"}, nil
+	}
+
+	invoice, err := s.client.SalesInvoices.Create(ctx, req.CompanyId, salesInvoiceFromProto(req.Invoice))
+	if err != nil {
+		return &vismav1.SalesInvoiceResponse{Success: false, Message: err.Error(), ErrorCode: visma.ErrorCode(err)}, nil
+	}
+	return &vismav1.SalesInvoiceResponse{Success: true, Message: "Sales invoice created successfully", Invoice: salesInvoiceToProto(invoice)}, nil
+}
+
+// ListInventoryItems returns a page of inventory items
+func (s *VismaServiceServer) ListInventoryItems(ctx context.Context, req *vismav1.ListRequest) (*vismav1.ListInventoryItemsResponse, error) {
+	page, err := s.client.Inventory.List(ctx, req.CompanyId, vismaListOptions(req))
+	if err != nil {
+		return &vismav1.ListInventoryItemsResponse{Success: false, Message: err.Error(), ErrorCode: visma.ErrorCode(err)}, nil
+	}
+
+	items := make([]*vismav1.InventoryItem, len(page.Items))
+	for i := range page.Items {
+		items[i] = inventoryItemToProto(&page.Items[i])
+	}
+	return &vismav1.ListInventoryItemsResponse{Success: true, Items: items, HasMore: page.HasMore}, nil
+}
+
+// GetInventoryItem returns an inventory item by number
+func (s *VismaServiceServer) GetInventoryItem(ctx context.Context, req *vismav1.GetRequest) (*vismav1.InventoryItemResponse, error) {
+	item, err := s.client.Inventory.Get(ctx, req.CompanyId, req.Key)
+	if err != nil {
+		return &vismav1.InventoryItemResponse{Success: false, Message: err.Error(), ErrorCode: visma.ErrorCode(err)}, nil
+	}
+	return &vismav1.InventoryItemResponse{Success: true, Item: inventoryItemToProto(item)}, nil
+}
+
+// ListProjects returns a page of projects
+func (s *VismaServiceServer) ListProjects(ctx context.Context, req *vismav1.ListRequest) (*vismav1.ListProjectsResponse, error) {
+	page, err := s.client.Projects.List(ctx, req.CompanyId, vismaListOptions(req))
+	if err != nil {
+		return &vismav1.ListProjectsResponse{Success: false, Message: err.Error(), ErrorCode: visma.ErrorCode(err)}, nil
+	}
+
+	projects := make([]*vismav1.Project, len(page.Items))
+	for i := range page.Items {
+		projects[i] = vismaProjectToProto(&page.Items[i])
+	}
+	return &vismav1.ListProjectsResponse{Success: true, Projects: projects, HasMore: page.HasMore}, nil
+}
+
+// GetProject returns a project by ID
+func (s *VismaServiceServer) GetProject(ctx context.Context, req *vismav1.GetRequest) (*vismav1.ProjectResponse, error) {
+	project, err := s.client.Projects.Get(ctx, req.CompanyId, req.Key)
+	if err != nil {
+		return &vismav1.ProjectResponse{Success: false, Message: err.Error(), ErrorCode: visma.ErrorCode(err)}, nil
+	}
+	return &vismav1.ProjectResponse{Success: true, Project: vismaProjectToProto(project)}, nil
+}
+
+// Helper functions
+
+// vismaListOptions converts a list request
+func vismaListOptions(req *vismav1.ListRequest) visma.ListOptions {
+	opts := visma.ListOptions{
+		PageNumber: int(req.PageNumber),
+		PageSize:   int(req.PageSize),
+		Params:     req.Filters,
+	}
+	if req.ModifiedSince != nil {
+		opts.ModifiedSince = req.ModifiedSince.AsTime()
+	}
+	return opts
+}
+
+// vismaTimestamp converts a Visma.net date to a protobuf timestamp
+func vismaTimestamp(d visma.DateTime) *timestamppb.Timestamp {
+	if d.IsZero() {
+		return nil
+	}
+	return timestamppb.New(d.Time)
+}
+
+// vismaDateTime converts a protobuf timestamp to a Visma.net date
+func vismaDateTime(ts *timestamppb.Timestamp) visma.DateTime {
+	if ts == nil {
+		return visma.DateTime{}
+	}
+	return visma.DateTime{Time: ts.AsTime()}
+}
+
+// addressToProto converts an address to protobuf
+func addressToProto(a *visma.Address) *vismav1.Address {
+	if a == nil {
+		return nil
+	}
+	address := &vismav1.Address{
+		AddressLine1: a.AddressLine1,
+		AddressLine2: a.AddressLine2,
+		PostalCode:   a.PostalCode,
+		City:         a.City,
+	}
+	if a.Country != nil {
+		address.CountryId = a.Country.ID
+	}
+	return address
+}
+
+// addressFromProto converts a protobuf address
+func addressFromProto(a *vismav1.Address) *visma.Address {
+	if a == nil {
+		return nil
+	}
+	address := &visma.Address{
+		AddressLine1: a.AddressLine1,
+		AddressLine2: a.AddressLine2,
+		PostalCode:   a.PostalCode,
+		City:         a.City,
+	}
+	if a.CountryId != "" {
+		address.Country = &visma.Country{ID: a.CountryId}
+	}
+	return address
+}
+
+// vismaContactToProto converts contact details to protobuf
+func vismaContactToProto(c *visma.Contact) *vismav1.Contact {
+	if c == nil {
+		return nil
+	}
+	return &vismav1.Contact{Name: c.Name, Email: c.Email, Phone: c.Phone1}
+}
+
+// vismaContactFromProto converts protobuf contact details
+func vismaContactFromProto(c *vismav1.Contact) *visma.Contact {
+	if c == nil {
+		return nil
+	}
+	return &visma.Contact{Name: c.Name, Email: c.Email, Phone1: c.Phone}
+}
+
+// referenceNumber returns the number of a reference
+func referenceNumber(ref *visma.Reference) string {
+	if ref == nil {
+		return ""
+	}
+	return ref.Number
+}
+
+// customerToProto converts a customer to protobuf
+func customerToProto(c *visma.Customer) *vismav1.Customer {
+	return &vismav1.Customer{
+		InternalId:        int32(c.InternalID),
+		Number:            c.Number,
+		Name:              c.Name,
+		Status:            c.Status,
+		CorporateId:       c.CorporateID,
+		VatRegistrationId: c.VatRegistrationID,
+		CurrencyId:        c.CurrencyID,
+		MainAddress:       addressToProto(c.MainAddress),
+		MainContact:       vismaContactToProto(c.MainContact),
+		LastModified:      vismaTimestamp(c.LastModifiedDateTime),
+	}
+}
+
+// customerFromProto converts a protobuf customer
+func customerFromProto(c *vismav1.Customer) *visma.Customer {
+	return &visma.Customer{
+		Number:            c.Number,
+		Name:              c.Name,
+		Status:            c.Status,
+		CorporateID:       c.CorporateId,
+		VatRegistrationID: c.VatRegistrationId,
+		CurrencyID:        c.CurrencyId,
+		MainAddress:       addressFromProto(c.MainAddress),
+		MainContact:       vismaContactFromProto(c.MainContact),
+	}
+}
+
+// supplierToProto converts a supplier to protobuf
+func supplierToProto(s *visma.Supplier) *vismav1.Supplier {
+	return &vismav1.Supplier{
+		InternalId:        int32(s.InternalID),
+		Number:            s.Number,
+		Name:              s.Name,
+		Status:            s.Status,
+		VatRegistrationId: s.VatRegistrationID,
+		CurrencyId:        s.CurrencyID,
+		MainAddress:       addressToProto(s.MainAddress),
+		MainContact:       vismaContactToProto(s.MainContact),
+		LastModified:      vismaTimestamp(s.LastModifiedDateTime),
+	}
+}
+
+// supplierFromProto converts a protobuf supplier
+func supplierFromProto(s *vismav1.Supplier) *visma.Supplier {
+	return &visma.Supplier{
+		Number:            s.Number,
+		Name:              s.Name,
+		Status:            s.Status,
+		VatRegistrationID: s.VatRegistrationId,
+		CurrencyID:        s.CurrencyId,
+		MainAddress:       addressFromProto(s.MainAddress),
+		MainContact:       vismaContactFromProto(s.MainContact),
+	}
+}
+
+// salesInvoiceToProto converts a sales invoice to protobuf
+func salesInvoiceToProto(i *visma.SalesInvoice) *vismav1.SalesInvoice {
+	lines := make([]*vismav1.InvoiceLine, len(i.InvoiceLines))
+	for n, line := range i.InvoiceLines {
+		lines[n] = &vismav1.InvoiceLine{
+			LineNumber:      int32(line.LineNumber),
+			InventoryNumber: line.InventoryNumber,
+			Description:     line.Description,
+			Quantity:        line.Quantity,
+			UnitPrice:       line.UnitPriceInCurrency,
+			Amount:          line.AmountInCurrency,
+		}
+	}
+
+	return &vismav1.SalesInvoice{
+		ReferenceNumber: i.ReferenceNumber,
+		DocumentType:    i.DocumentType,
+		Status:          i.Status,
+		CustomerNumber:  referenceNumber(i.Customer),
+		DocumentDate:    vismaTimestamp(i.DocumentDate),
+		DueDate:         vismaTimestamp(i.DueDate),
+		CurrencyId:      i.CurrencyID,
+		Amount:          i.Amount,
+		Balance:         i.Balance,
+		VatTotal:        i.VatTotal,
+		Lines:           lines,
+		LastModified:    vismaTimestamp(i.LastModifiedDateTime),
+	}
+}
+
+// salesInvoiceFromProto converts a protobuf sales invoice
+func salesInvoiceFromProto(i *vismav1.SalesInvoice) *visma.SalesInvoice {
+	lines := make([]visma.InvoiceLine, len(i.Lines))
+	for n, line := range i.Lines {
+		lines[n] = visma.InvoiceLine{
+			InventoryNumber:     line.InventoryNumber,
+			Description:         line.Description,
+			Quantity:            line.Quantity,
+			UnitPriceInCurrency: line.UnitPrice,
+		}
+	}
+
+	return &visma.SalesInvoice{
+		ReferenceNumber: i.ReferenceNumber,
+		Customer:        &visma.Reference{Number: i.CustomerNumber},
+		DocumentDate:    vismaDateTime(i.DocumentDate),
+		DueDate:         vismaDateTime(i.DueDate),
+		CurrencyID:      i.CurrencyId,
+		InvoiceLines:    lines,
+	}
+}
+
+// inventoryItemToProto converts an inventory item to protobuf
+func inventoryItemToProto(i *visma.InventoryItem) *vismav1.InventoryItem {
+	return &vismav1.InventoryItem{
+		InventoryId:     int32(i.InventoryID),
+		InventoryNumber: i.InventoryNumber,
+		Description:     i.Description,
+		Status:          i.Status,
+		Type:            i.Type,
+		DefaultPrice:    i.DefaultPrice,
+		BaseUnit:        i.BaseUnit,
+		LastModified:    vismaTimestamp(i.LastModifiedDateTime),
+	}
+}
+
+// vismaProjectToProto converts a project to protobuf
+func vismaProjectToProto(p *visma.Project) *vismav1.Project {
+	return &vismav1.Project{
+		InternalId:     int32(p.InternalID),
+		ProjectId:      p.ProjectID,
+		Description:    p.Description,
+		Status:         p.Status,
+		CustomerNumber: referenceNumber(p.Customer),
+		StartDate:      vismaTimestamp(p.StartDate),
+		EndDate:        vismaTimestamp(p.EndDate),
+		LastModified:   vismaTimestamp(p.LastModifiedDateTime),
+	}
+}
@@ -0,0 +1,284 @@
+package idempotency
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/aquatiq/integration-gateway/internal/audit"
+	"github.com/aquatiq/integration-gateway/internal/auth"
+	"github.com/aquatiq/integration-gateway/internal/cache"
+)
+
+// HeaderName is the request header clients use to send idempotency keys
+const HeaderName = "Idempotency-Key"
+
+// maxStoredBody is the largest response body that is stored for replay
+const maxStoredBody = 1 << 20 // 1MB
+
+// defaultMaxBodyBytes is the largest request body read for fingerprinting
+const defaultMaxBodyBytes = 10 << 20 // 10MB
+
+// Config holds idempotency middleware configuration
+type Config struct {
+	Cache        *cache.RedisCache
+	TTL          time.Duration // How long completed responses are kept for replay
+	LockTTL      time.Duration // How long an in-flight request blocks its key
+	MaxBodyBytes int64         // Largest request body read for fingerprinting
+	AuditLogger  *audit.AuditLogger
+}
+
+// Store makes gateway write endpoints safe to retry by replaying
+// the stored response for a repeated Idempotency-Key
+type Store struct {
+	cache   *cache.RedisCache
+	ttl     time.Duration
+	lockTTL time.Duration
+	maxBody int64
+	audit   *audit.AuditLogger
+	prefix  string
+}
+
+// record is a stored idempotent request and its response
+type record struct {
+	State       string      `json:"state"` // "processing" or "completed"
+	Fingerprint string      `json:"fingerprint"`
+	StatusCode  int         `json:"status_code,omitempty"`
+	Header      http.Header `json:"header,omitempty"`
+	Body        []byte      `json:"body,omitempty"`
+	CreatedAt   time.Time   `json:"created_at"`
+}
+
+// New creates a new idempotency store
+func New(cfg Config) *Store {
+	if cfg.TTL == 0 {
+		cfg.TTL = 24 * time.Hour
+	}
+	if cfg.LockTTL == 0 {
+		cfg.LockTTL = time.Minute
+	}
+	if cfg.MaxBodyBytes == 0 {
+		cfg.MaxBodyBytes = defaultMaxBodyBytes
+	}
+
+	return &Store{
+		cache:   cfg.Cache,
+		ttl:     cfg.TTL,
+		lockTTL: cfg.LockTTL,
+		maxBody: cfg.MaxBodyBytes,
+		audit:   cfg.AuditLogger,
+		prefix:  "idempotency:",
+	}
+}
+
+// Middleware returns a middleware that deduplicates write requests by
+// Idempotency-Key. Stored responses are replayed to anyone presenting the same
+// credential, so it must run after authentication and authorization.
+func (s *Store) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(HeaderName)
+		if key == "" || !isWriteMethod(r.Method) || s.cache == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if len(key) > 255 {
+			respondError(w, http.StatusBadRequest, "invalid_idempotency_key", "Idempotency-Key must be at most 255 characters")
+			return
+		}
+
+		// Read the body so it can be fingerprinted and passed on; the read is
+		// bounded because the handler's own limit has not applied yet
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, s.maxBody))
+		if err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				respondError(w, http.StatusRequestEntityTooLarge, "body_too_large", "Request body is too large")
+				return
+			}
+			respondError(w, http.StatusBadRequest, "invalid_body", "Failed to read request body")
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		storeKey := s.prefix + clientScope(r) + ":" + key
+		fingerprint := requestFingerprint(r, body)
+
+		// Claim the key; if it is taken, replay or reject
+		claimed, err := s.cache.SetNX(storeKey, record{
+			State:       "processing",
+			Fingerprint: fingerprint,
+			CreatedAt:   time.Now(),
+		}, s.lockTTL)
+		if err != nil {
+			// Redis unavailable - process without deduplication
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if !claimed {
+			s.replay(w, r, storeKey, fingerprint)
+			return
+		}
+
+		rec := &responseRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+		next.ServeHTTP(rec, r)
+
+		// Server errors and oversized responses are not stored, so the client can retry
+		if rec.statusCode >= 500 || rec.overflow {
+			_ = s.cache.Delete(storeKey)
+			return
+		}
+
+		_ = s.cache.Set(storeKey, record{
+			State:       "completed",
+			Fingerprint: fingerprint,
+			StatusCode:  rec.statusCode,
+			Header:      storableHeader(rec.Header()),
+			Body:        rec.body.Bytes(),
+			CreatedAt:   time.Now(),
+		}, s.ttl)
+	})
+}
+
+// replay sends the stored response for a repeated key
+func (s *Store) replay(w http.ResponseWriter, r *http.Request, storeKey, fingerprint string) {
+	var stored record
+	if err := s.cache.Get(storeKey, &stored); err != nil {
+		respondError(w, http.StatusConflict, "idempotency_conflict", "Request with this Idempotency-Key is being processed")
+		return
+	}
+
+	if stored.Fingerprint != fingerprint {
+		s.logReplay(r, false, "fingerprint_mismatch")
+		respondError(w, http.StatusUnprocessableEntity, "idempotency_key_reused", "Idempotency-Key was already used for a different request")
+		return
+	}
+
+	if stored.State != "completed" {
+		w.Header().Set("Retry-After", "1")
+		respondError(w, http.StatusConflict, "idempotency_conflict", "Request with this Idempotency-Key is being processed")
+		return
+	}
+
+	for name, values := range stored.Header {
+		w.Header()[name] = values
+	}
+	w.Header().Set("Idempotent-Replayed", "true")
+	w.WriteHeader(stored.StatusCode)
+	w.Write(stored.Body)
+
+	s.logReplay(r, true, "")
+}
+
+// logReplay logs an idempotent replay or rejection
+func (s *Store) logReplay(r *http.Request, success bool, reason string) {
+	if s.audit == nil {
+		return
+	}
+
+	if success {
+		s.audit.LogHTTPRequest(r, "idempotent_replay", true, nil, 0)
+	} else {
+		s.audit.LogHTTPRequest(r, "idempotency_key_rejected", false, errors.New(reason), 0)
+	}
+}
+
+// responseRecorder passes a response through while keeping a copy
+type responseRecorder struct {
+	http.ResponseWriter
+	statusCode  int
+	body        bytes.Buffer
+	overflow    bool
+	wroteHeader bool
+}
+
+// WriteHeader captures the status code
+func (r *responseRecorder) WriteHeader(code int) {
+	if !r.wroteHeader {
+		r.statusCode = code
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(code)
+}
+
+// Write captures the body up to maxStoredBody
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	if !r.overflow {
+		if r.body.Len()+len(b) > maxStoredBody {
+			r.overflow = true
+			r.body.Reset()
+		} else {
+			r.body.Write(b)
+		}
+	}
+	return r.ResponseWriter.Write(b)
+}
+
+// storableHeader copies the response headers that belong to the handler's
+// response; encoding and rate limit headers are recomputed on replay
+func storableHeader(header http.Header) http.Header {
+	stored := header.Clone()
+	for _, name := range []string{
+		"Content-Encoding", "Content-Length", "Vary", "Date",
+		"X-Ratelimit-Limit", "X-Ratelimit-Remaining", "Retry-After",
+	} {
+		stored.Del(name)
+	}
+	return stored
+}
+
+// isWriteMethod reports whether the method modifies state
+func isWriteMethod(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// clientScope identifies the caller so keys from different clients never
+// collide; the authenticated API key counts however it was sent
+func clientScope(r *http.Request) string {
+	credential := r.Header.Get("X-API-Key")
+	if key, ok := auth.APIKeyFromContext(r.Context()); ok {
+		credential = key.Key
+	}
+	if credential == "" {
+		credential = r.Header.Get("Authorization")
+	}
+	if credential == "" {
+		return "anonymous"
+	}
+
+	hash := sha256.Sum256([]byte(credential))
+	return hex.EncodeToString(hash[:8])
+}
+
+// requestFingerprint hashes the parts of a request that must match on replay
+func requestFingerprint(r *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.Method))
+	h.Write([]byte{0})
+	h.Write([]byte(r.URL.RequestURI()))
+	h.Write([]byte{0})
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// respondError sends a JSON error response
+func respondError(w http.ResponseWriter, status int, code, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{
+		"error":   code,
+		"message": message,
+	})
+}
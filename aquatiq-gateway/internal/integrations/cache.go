@@ -0,0 +1,225 @@
+package integrations
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/aquatiq/integration-gateway/internal/cache"
+)
+
+// bypassCacheCtxKey marks calls that must reach the provider
+type bypassCacheCtxKey struct{}
+
+// WithoutCache makes GET calls skip the response cache, e.g. for health
+// checks or syncs that must see the provider's current data. Fresh
+// responses are still stored for other callers.
+func WithoutCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bypassCacheCtxKey{}, true)
+}
+
+// ResponseCache caches integration GET responses in Redis. Cached responses
+// are grouped by scope (a SuperOffice tenant or a Visma company) and
+// resource. Invalidation bumps generation counters that are part of every
+// cache key, so stale responses are never read again and simply expire.
+type ResponseCache struct {
+	cache     *cache.QueryCache
+	service   string
+	ttl       time.Duration
+	resources map[string]time.Duration
+
+	hits          atomic.Uint64
+	misses        atomic.Uint64
+	invalidations atomic.Uint64
+	errors        atomic.Uint64
+}
+
+// CacheStats holds response cache statistics
+type CacheStats struct {
+	Enabled       bool   `json:"enabled"`
+	Hits          uint64 `json:"hits"`
+	Misses        uint64 `json:"misses"`
+	Invalidations uint64 `json:"invalidations"`
+	Errors        uint64 `json:"errors"`
+}
+
+// NewResponseCache creates the response cache of an integration, or returns
+// nil when caching is disabled or Redis is unavailable
+func NewResponseCache(s Settings, deps Dependencies) *ResponseCache {
+	if !s.Cache.Enabled || deps.Cache == nil {
+		return nil
+	}
+	if s.Cache.TTL <= 0 {
+		s.Cache.TTL = 5 * time.Minute
+	}
+
+	resources := make(map[string]time.Duration, len(s.Cache.Resources))
+	for resource, ttl := range s.Cache.Resources {
+		resources[strings.ToLower(resource)] = ttl
+	}
+
+	return &ResponseCache{
+		cache:     deps.Cache,
+		service:   s.Name,
+		ttl:       s.Cache.TTL,
+		resources: resources,
+	}
+}
+
+// Fetch returns the cached response of a GET request or calls fetch and
+// caches its result. An empty id marks a list request. Redis errors never
+// fail the call; the provider is asked instead. Nil-safe.
+func (c *ResponseCache) Fetch(ctx context.Context, scope, resource, id, rawURL string, fetch func() ([]byte, error)) ([]byte, error) {
+	ttl := c.resourceTTL(resource)
+	if ttl <= 0 {
+		return fetch()
+	}
+
+	namespace := c.namespace(scope, resource)
+	counters := []string{namespace + ":all", namespace + ":list"}
+	if id != "" {
+		counters[1] = namespace + ":id:" + id
+	}
+
+	// Generations are read before fetching, so a change made while the
+	// request is in flight leaves its response under an outdated key
+	generations, err := c.cache.Generations(ctx, counters...)
+	if err != nil {
+		c.errors.Add(1)
+		return fetch()
+	}
+	query := rawURL + "#" + strconv.FormatInt(generations[0], 10) + "." + strconv.FormatInt(generations[1], 10)
+
+	if ctx.Value(bypassCacheCtxKey{}) == nil {
+		var cached json.RawMessage
+		hit, err := c.cache.GetIn(ctx, namespace, query, &cached)
+		if err != nil {
+			c.errors.Add(1)
+		}
+		if hit {
+			c.hits.Add(1)
+			return cached, nil
+		}
+	}
+	c.misses.Add(1)
+
+	body, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+	if json.Valid(body) {
+		if err := c.cache.SetIn(ctx, namespace, query, json.RawMessage(body), ttl); err != nil {
+			c.errors.Add(1)
+		}
+	}
+	return body, nil
+}
+
+// Invalidate drops cached copies of one entity and all cached lists of its
+// resource. An empty id only drops the lists, e.g. after a create.
+func (c *ResponseCache) Invalidate(ctx context.Context, scope, resource, id string) error {
+	if c == nil {
+		return nil
+	}
+
+	namespace := c.namespace(scope, resource)
+	counters := []string{namespace + ":list"}
+	if id != "" {
+		counters = append(counters, namespace+":id:"+id)
+	}
+	return c.bump(ctx, counters...)
+}
+
+// InvalidateResource drops every cached response of a resource in a scope
+func (c *ResponseCache) InvalidateResource(ctx context.Context, scope, resource string) error {
+	if c == nil {
+		return nil
+	}
+	return c.bump(ctx, c.namespace(scope, resource)+":all")
+}
+
+// Stats returns response cache statistics
+func (c *ResponseCache) Stats() CacheStats {
+	if c == nil {
+		return CacheStats{}
+	}
+	return CacheStats{
+		Enabled:       true,
+		Hits:          c.hits.Load(),
+		Misses:        c.misses.Load(),
+		Invalidations: c.invalidations.Load(),
+		Errors:        c.errors.Load(),
+	}
+}
+
+// bump increments generation counters
+func (c *ResponseCache) bump(ctx context.Context, counters ...string) error {
+	if err := c.cache.Bump(ctx, counters...); err != nil {
+		c.errors.Add(1)
+		return err
+	}
+	c.invalidations.Add(1)
+	return nil
+}
+
+// resourceTTL returns how long responses of a resource are cached
+func (c *ResponseCache) resourceTTL(resource string) time.Duration {
+	if c == nil {
+		return 0
+	}
+	if ttl, ok := c.resources[strings.ToLower(resource)]; ok {
+		return ttl
+	}
+	return c.ttl
+}
+
+// namespace returns the key namespace of a resource in a scope
+func (c *ResponseCache) namespace(scope, resource string) string {
+	return c.service + ":" + scope + ":" + strings.ToLower(resource)
+}
+
+// Helper functions
+
+// SplitResourceURL returns the resource and entity ID an API URL refers to,
+// e.g. "Contact" and "123" for {baseURL}/Contact/123?$select=name.
+// The ID is empty for collection URLs.
+func SplitResourceURL(baseURL, rawURL string) (resource, id string) {
+	rel := strings.TrimPrefix(rawURL, strings.TrimRight(baseURL, "/")+"/")
+	rel, _, _ = strings.Cut(rel, "?")
+	resource, id, _ = strings.Cut(rel, "/")
+	id, _, _ = strings.Cut(id, "/")
+	if unescaped, err := url.PathUnescape(id); err == nil {
+		id = unescaped
+	}
+	return resource, id
+}
+
+// PayloadField returns the first top-level string or number field of a JSON
+// object among names, matching names case-insensitively
+func PayloadField(payload []byte, names ...string) string {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &fields); err != nil {
+		return ""
+	}
+
+	for _, name := range names {
+		for key, raw := range fields {
+			if !strings.EqualFold(key, name) {
+				continue
+			}
+			var s string
+			if err := json.Unmarshal(raw, &s); err == nil && s != "" {
+				return s
+			}
+			var n json.Number
+			if err := json.Unmarshal(raw, &n); err == nil {
+				return n.String()
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,158 @@
+package integrations
+
+import (
+	"context"
+	"time"
+
+	"github.com/aquatiq/integration-gateway/internal/audit"
+	"github.com/aquatiq/integration-gateway/internal/cache"
+	"github.com/aquatiq/integration-gateway/internal/config"
+	"github.com/aquatiq/integration-gateway/pkg/circuitbreaker"
+	"github.com/aquatiq/integration-gateway/pkg/httpclient"
+	"github.com/sony/gobreaker/v2"
+)
+
+// Dependencies are the shared components every integration client uses
+type Dependencies struct {
+	Tokens         httpclient.TokenSource // OAuth2 token manager
+	AuditLogger    *audit.AuditLogger
+	Logging        *httpclient.LogSettings
+	CircuitBreaker config.CircuitBreakerConfig
+	Breakers       *circuitbreaker.Manager     // Optional; the integration's breaker is registered here
+	Quotas         *cache.QuotaStore           // Optional; shares provider quotas between replicas
+	DeadLetters    httpclient.DeadLetterSink   // Optional; keeps writes that fail after all retries
+	Cache          *cache.QueryCache           // Optional; caches GET responses
+	Validator      httpclient.PayloadValidator // Optional; checks payloads against schemas
+}
+
+// Settings holds the per-integration client settings
+type Settings struct {
+	Name        string
+	App         string // OAuth client ID, so quotas are counted per app
+	Timeout     time.Duration
+	RetryMax    int
+	Auth        config.IntegrationAuthConfig
+	Bulkhead    config.BulkheadConfig
+	RateLimit   config.OutboundRateLimitConfig
+	RetryBudget config.RetryBudgetConfig
+	Hedge       config.HedgeConfig
+	Idempotency config.IdempotencyKeyConfig
+	Cassette    config.CassetteConfig
+	Cache       config.ResponseCacheConfig
+}
+
+// NewHTTPClient creates a resilient HTTP client with its own circuit breaker
+func NewHTTPClient(s Settings, deps Dependencies) *httpclient.Client {
+	if s.Timeout == 0 {
+		s.Timeout = 30 * time.Second
+	}
+
+	cb := circuitbreaker.New(circuitbreaker.Config{
+		Name:             s.Name,
+		MaxRequests:      deps.CircuitBreaker.MaxRequests,
+		Interval:         deps.CircuitBreaker.Interval,
+		Timeout:          deps.CircuitBreaker.Timeout,
+		FailureThreshold: deps.CircuitBreaker.FailureThreshold,
+		OnStateChange: func(name string, from, to gobreaker.State) {
+			if deps.AuditLogger != nil {
+				deps.AuditLogger.LogCircuitBreakerStateChange(name, circuitbreaker.StateString(from), circuitbreaker.StateString(to))
+			}
+		},
+	})
+	if deps.Breakers != nil {
+		deps.Breakers.Add(s.Name, cb)
+	}
+
+	var quotaStore httpclient.QuotaStore
+	if deps.Quotas != nil {
+		quotaStore = sharedQuotas{deps.Quotas}
+	}
+
+	return httpclient.New(httpclient.Config{
+		RetryMax:       s.RetryMax,
+		RetryWaitMin:   500 * time.Millisecond,
+		RetryWaitMax:   30 * time.Second,
+		Timeout:        s.Timeout,
+		ServiceName:    s.Name,
+		CircuitBreaker: cb,
+		AuditLogger:    deps.AuditLogger,
+		Bulkhead: httpclient.BulkheadConfig{
+			MaxConcurrent:   s.Bulkhead.MaxConcurrent,
+			MaxQueue:        s.Bulkhead.MaxQueue,
+			QueueTimeout:    s.Bulkhead.QueueTimeout,
+			MaxConnsPerHost: s.Bulkhead.MaxConnsPerHost,
+		},
+		RateLimit: httpclient.RateLimitConfig{
+			RequestsPerSecond: s.RateLimit.RequestsPerSecond,
+			Burst:             s.RateLimit.Burst,
+			MaxWait:           s.RateLimit.MaxWait,
+			MaxQueue:          s.RateLimit.MaxQueue,
+			Quotas:            quotas(s.RateLimit.Quotas),
+			App:               s.App,
+			Store:             quotaStore,
+		},
+		RetryBudget: httpclient.RetryBudgetConfig{
+			Ratio:        s.RetryBudget.Ratio,
+			MinPerSecond: s.RetryBudget.MinPerSecond,
+			MaxTokens:    s.RetryBudget.MaxTokens,
+		},
+		Hedge: httpclient.HedgeConfig{
+			Enabled:    s.Hedge.Enabled,
+			Percentile: s.Hedge.Percentile,
+			MinDelay:   s.Hedge.MinDelay,
+		},
+		Idempotency: httpclient.IdempotencyConfig{
+			HeaderName:        s.Idempotency.HeaderName,
+			ProviderSupported: s.Idempotency.Supported,
+		},
+		Auth: httpclient.AuthConfig{
+			Type:       s.Auth.Type,
+			HeaderName: s.Auth.HeaderName,
+			APIKey:     s.Auth.APIKey,
+			Username:   s.Auth.Username,
+			Password:   s.Auth.Password,
+			Service:    s.Name,
+			Tokens:     deps.Tokens,
+		},
+		Logging:     deps.Logging,
+		DeadLetters: deps.DeadLetters,
+		Validator:   deps.Validator,
+		Cassette: httpclient.CassetteConfig{
+			Mode: s.Cassette.Mode,
+			Path: s.Cassette.Path,
+		},
+	})
+}
+
+// quotas converts configured provider quotas
+func quotas(configured []config.OutboundQuotaConfig) []httpclient.QuotaConfig {
+	quotas := make([]httpclient.QuotaConfig, len(configured))
+	for i, q := range configured {
+		quotas[i] = httpclient.QuotaConfig{Name: q.Name, Scope: q.Scope, Limit: q.Limit, Window: q.Window}
+	}
+	return quotas
+}
+
+// sharedQuotas counts provider quotas in Redis for the HTTP client
+type sharedQuotas struct {
+	store *cache.QuotaStore
+}
+
+// Take implements httpclient.QuotaStore
+func (q sharedQuotas) Take(ctx context.Context, windows []httpclient.QuotaWindow) (httpclient.QuotaResult, error) {
+	shared := make([]cache.QuotaWindow, len(windows))
+	for i, w := range windows {
+		shared[i] = cache.QuotaWindow{Key: w.Key, Limit: w.Limit, Window: w.Window}
+	}
+
+	result, err := q.store.Take(ctx, shared)
+	if err != nil {
+		return httpclient.QuotaResult{}, err
+	}
+	return httpclient.QuotaResult{
+		Allowed:    result.Allowed,
+		RetryAfter: result.RetryAfter,
+		Exceeded:   result.Exceeded,
+		Remaining:  result.Remaining,
+	}, nil
+}
@@ -0,0 +1,285 @@
+package superoffice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/aquatiq/integration-gateway/internal/config"
+	"github.com/aquatiq/integration-gateway/internal/integrations"
+	"github.com/aquatiq/integration-gateway/pkg/httpclient"
+)
+
+// ServiceName identifies SuperOffice in tokens, breakers and audit logs
+const ServiceName = "superoffice"
+
+// ErrNotFound is returned when an entity does not exist
+var ErrNotFound = errors.New("superoffice: entity not found")
+
+// Client is a SuperOffice REST API client
+type Client struct {
+	http     *httpclient.Client
+	cache    *integrations.ResponseCache // Nil when caching is disabled
+	baseURL  string
+	tenantID string
+
+	Contacts     *Resource[Contact]
+	Persons      *Resource[Person]
+	Sales        *Resource[Sale]
+	Projects     *Resource[Project]
+	Appointments *Resource[Appointment]
+}
+
+// New creates a new SuperOffice client with its own circuit breaker
+func New(cfg config.SuperOfficeConfig, deps integrations.Dependencies) (*Client, error) {
+	if cfg.BaseURL == "" || cfg.TenantID == "" {
+		return nil, fmt.Errorf("superoffice base URL and tenant ID are required")
+	}
+
+	settings := integrations.Settings{
+		Name:        ServiceName,
+		App:         cfg.ClientID,
+		Timeout:     cfg.Timeout,
+		RetryMax:    cfg.RetryMax,
+		Auth:        cfg.Auth,
+		Bulkhead:    cfg.Bulkhead,
+		RateLimit:   cfg.RateLimit,
+		RetryBudget: cfg.RetryBudget,
+		Hedge:       cfg.Hedge,
+		Idempotency: cfg.Idempotency,
+		Cassette:    cfg.Cassette,
+		Cache:       cfg.Cache,
+	}
+	c := &Client{
+		http:     integrations.NewHTTPClient(settings, deps),
+		cache:    integrations.NewResponseCache(settings, deps),
+		baseURL:  strings.TrimRight(cfg.BaseURL, "/") + "/" + cfg.TenantID + "/api/v1",
+		tenantID: cfg.TenantID,
+	}
+
+	c.Contacts = &Resource[Contact]{client: c, path: "Contact"}
+	c.Persons = &Resource[Person]{client: c, path: "Person"}
+	c.Sales = &Resource[Sale]{client: c, path: "Sale"}
+	c.Projects = &Resource[Project]{client: c, path: "Project"}
+	c.Appointments = &Resource[Appointment]{client: c, path: "Appointment"}
+
+	return c, nil
+}
+
+// Stats returns HTTP client statistics
+func (c *Client) Stats() httpclient.Stats {
+	return c.http.Stats()
+}
+
+// CacheStats returns response cache statistics
+func (c *Client) CacheStats() integrations.CacheStats {
+	return c.cache.Stats()
+}
+
+// HTTP returns the resilient HTTP client, for forwarding raw API requests
+func (c *Client) HTTP() *httpclient.Client {
+	return c.http
+}
+
+// BaseURL returns the root of the SuperOffice REST API
+func (c *Client) BaseURL() string {
+	return c.baseURL
+}
+
+// Ping performs the cheapest authenticated call, fetching one contact ID,
+// to check that the API is reachable and the credentials are accepted
+func (c *Client) Ping(ctx context.Context) error {
+	_, err := c.Contacts.List(integrations.WithoutCache(ctx), Query{Select: []string{"contactId"}, Top: 1})
+	return err
+}
+
+// InvalidateFromWebhook drops cached copies of the entity a webhook event
+// reports as changed. SuperOffice events name the entity and its primary
+// key; events without a key drop every cached response of the entity type.
+func (c *Client) InvalidateFromWebhook(ctx context.Context, eventType string, payload []byte) error {
+	if c.cache == nil {
+		return nil
+	}
+
+	if tenant := integrations.PayloadField(payload, "ContextIdentifier"); tenant != "" && !strings.EqualFold(tenant, c.tenantID) {
+		return nil
+	}
+
+	entity := integrations.PayloadField(payload, "Entity")
+	if entity == "" {
+		entity, _, _ = strings.Cut(eventType, ".")
+	}
+	if entity == "" {
+		return fmt.Errorf("superoffice event %q does not name an entity", eventType)
+	}
+
+	if id := integrations.PayloadField(payload, "PrimaryKey"); id != "" && id != "0" {
+		return c.cache.Invalidate(ctx, c.tenantID, entity, id)
+	}
+	return c.cache.InvalidateResource(ctx, c.tenantID, entity)
+}
+
+// Page is one page of a list response
+type Page[T any] struct {
+	Items    []T    `json:"value"`
+	NextLink string `json:"odata.nextLink,omitempty"`
+}
+
+// Resource provides CRUD and list operations for one entity type
+type Resource[T any] struct {
+	client *Client
+	path   string
+}
+
+// List returns the first page of entities matching the query
+func (r *Resource[T]) List(ctx context.Context, q Query) (*Page[T], error) {
+	url := r.client.baseURL + "/" + r.path
+	if values := q.Values(); len(values) > 0 {
+		url += "?" + values.Encode()
+	}
+	return r.page(ctx, url)
+}
+
+// Next returns the page at a NextLink from a previous page
+func (r *Resource[T]) Next(ctx context.Context, nextLink string) (*Page[T], error) {
+	// The link may come from a caller, so never follow it off the API
+	if !strings.HasPrefix(nextLink, r.client.baseURL+"/"+r.path) {
+		return nil, fmt.Errorf("invalid superoffice page link")
+	}
+	return r.page(ctx, nextLink)
+}
+
+// ListAll calls fn for every page of entities matching the query
+func (r *Resource[T]) ListAll(ctx context.Context, q Query, fn func([]T) error) error {
+	page, err := r.List(ctx, q)
+	for {
+		if err != nil {
+			return err
+		}
+		if err := fn(page.Items); err != nil {
+			return err
+		}
+		if page.NextLink == "" || len(page.Items) == 0 {
+			return nil
+		}
+		page, err = r.Next(ctx, page.NextLink)
+	}
+}
+
+// Get returns one entity by ID
+func (r *Resource[T]) Get(ctx context.Context, id int) (*T, error) {
+	var entity T
+	if err := r.client.do(ctx, http.MethodGet, r.entityURL(id), nil, &entity); err != nil {
+		return nil, err
+	}
+	return &entity, nil
+}
+
+// Create creates an entity and returns it as stored
+func (r *Resource[T]) Create(ctx context.Context, entity *T) (*T, error) {
+	var created T
+	if err := r.client.do(ctx, http.MethodPost, r.client.baseURL+"/"+r.path, entity, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// Update replaces an entity and returns it as stored
+func (r *Resource[T]) Update(ctx context.Context, id int, entity *T) (*T, error) {
+	var updated T
+	if err := r.client.do(ctx, http.MethodPut, r.entityURL(id), entity, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// Delete deletes an entity
+func (r *Resource[T]) Delete(ctx context.Context, id int) error {
+	return r.client.do(ctx, http.MethodDelete, r.entityURL(id), nil, nil)
+}
+
+// entityURL returns the URL of one entity
+func (r *Resource[T]) entityURL(id int) string {
+	return r.client.baseURL + "/" + r.path + "/" + strconv.Itoa(id)
+}
+
+// page fetches and decodes one list page
+func (r *Resource[T]) page(ctx context.Context, url string) (*Page[T], error) {
+	var page Page[T]
+	if err := r.client.do(ctx, http.MethodGet, url, nil, &page); err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
+
+// do sends a JSON request and decodes the JSON response into out. GET
+// responses are served from the cache when possible; other requests drop
+// cached copies of the entity they change, even when they fail, since a
+// timed-out write may still have been applied.
+func (c *Client) do(ctx context.Context, method, url string, in, out interface{}) error {
+	resource, id := integrations.SplitResourceURL(c.baseURL, url)
+
+	var body []byte
+	var err error
+	if method == http.MethodGet {
+		body, err = c.cache.Fetch(ctx, c.tenantID, resource, id, url, func() ([]byte, error) {
+			return c.send(ctx, method, url, nil)
+		})
+	} else {
+		body, err = c.send(ctx, method, url, in)
+		// A failed invalidation is counted in the cache stats; entries expire with their TTL
+		_ = c.cache.Invalidate(ctx, c.tenantID, resource, id)
+	}
+	if err != nil {
+		return err
+	}
+
+	if out == nil || len(body) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("failed to decode superoffice response: %w", err)
+	}
+	return nil
+}
+
+// send sends a JSON request and returns the response body
+func (c *Client) send(ctx context.Context, method, url string, in interface{}) ([]byte, error) {
+	var body []byte
+	if in != nil {
+		var err error
+		if body, err = json.Marshal(in); err != nil {
+			return nil, fmt.Errorf("failed to encode request: %w", err)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		if httpclient.IsStatus(err, http.StatusNotFound) {
+			return nil, fmt.Errorf("%w: %s", ErrNotFound, strings.TrimPrefix(url, c.baseURL))
+		}
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read superoffice response: %w", err)
+	}
+	return data, nil
+}
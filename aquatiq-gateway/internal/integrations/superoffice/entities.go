@@ -0,0 +1,150 @@
+package superoffice
+
+import (
+	"strings"
+	"time"
+)
+
+// DateTime is a SuperOffice timestamp; the API omits the time zone
+type DateTime struct {
+	time.Time
+}
+
+// dateTimeLayouts are the formats SuperOffice uses for dates
+var dateTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+}
+
+// UnmarshalJSON parses SuperOffice dates (UTC when no zone is given)
+func (d *DateTime) UnmarshalJSON(data []byte) error {
+	value := strings.Trim(string(data), `"`)
+	if value == "" || value == "null" {
+		d.Time = time.Time{}
+		return nil
+	}
+
+	var err error
+	for _, layout := range dateTimeLayouts {
+		var t time.Time
+		if t, err = time.ParseInLocation(layout, value, time.UTC); err == nil {
+			d.Time = t
+			return nil
+		}
+	}
+	return err
+}
+
+// MarshalJSON formats dates the way SuperOffice expects them
+func (d DateTime) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + d.UTC().Format("2006-01-02T15:04:05") + `"`), nil
+}
+
+// EntityElement is a phone number, email address or URL
+type EntityElement struct {
+	Value       string `json:"Value"`
+	Description string `json:"Description,omitempty"`
+}
+
+// Address is a postal or street address
+type Address struct {
+	Address1 string `json:"Address1,omitempty"`
+	Address2 string `json:"Address2,omitempty"`
+	Zipcode  string `json:"Zipcode,omitempty"`
+	City     string `json:"City,omitempty"`
+}
+
+// ContactAddress holds the addresses of a contact
+type ContactAddress struct {
+	Postal Address `json:"Postal"`
+	Street Address `json:"Street"`
+}
+
+// ContactRef references a contact (company)
+type ContactRef struct {
+	ContactID int    `json:"ContactId"`
+	Name      string `json:"Name,omitempty"`
+}
+
+// PersonRef references a person
+type PersonRef struct {
+	PersonID int    `json:"PersonId"`
+	FullName string `json:"FullName,omitempty"`
+}
+
+// Contact is a SuperOffice company
+type Contact struct {
+	ContactID   int             `json:"ContactId"`
+	Name        string          `json:"Name"`
+	Department  string          `json:"Department,omitempty"`
+	OrgNr       string          `json:"OrgNr,omitempty"`
+	Number      string          `json:"Number2,omitempty"` // Customer number
+	Phones      []EntityElement `json:"Phones,omitempty"`
+	Emails      []EntityElement `json:"Emails,omitempty"`
+	Urls        []EntityElement `json:"Urls,omitempty"`
+	Address     *ContactAddress `json:"Address,omitempty"`
+	CountryID   int             `json:"CountryId,omitempty"`
+	Deleted     bool            `json:"Deleted,omitempty"`
+	CreatedDate DateTime        `json:"CreatedDate"`
+	UpdatedDate DateTime        `json:"UpdatedDate"`
+}
+
+// Person is a contact person
+type Person struct {
+	PersonID     int             `json:"PersonId"`
+	Firstname    string          `json:"Firstname"`
+	Lastname     string          `json:"Lastname"`
+	Title        string          `json:"Title,omitempty"`
+	Contact      *ContactRef     `json:"Contact,omitempty"`
+	Emails       []EntityElement `json:"Emails,omitempty"`
+	OfficePhones []EntityElement `json:"OfficePhones,omitempty"`
+	MobilePhones []EntityElement `json:"MobilePhones,omitempty"`
+	CreatedDate  DateTime        `json:"CreatedDate"`
+	UpdatedDate  DateTime        `json:"UpdatedDate"`
+}
+
+// Sale is a sales opportunity
+type Sale struct {
+	SaleID      int         `json:"SaleId"`
+	Heading     string      `json:"Heading"`
+	Description string      `json:"Description,omitempty"`
+	Amount      float64     `json:"Amount"`
+	Currency    string      `json:"Currency,omitempty"`
+	Probability int         `json:"Probability"`
+	Status      string      `json:"Status"` // Open, Sold, Lost or Stalled
+	SaleDate    DateTime    `json:"Saledate"`
+	Contact     *ContactRef `json:"Contact,omitempty"`
+	Person      *PersonRef  `json:"Person,omitempty"`
+	CreatedDate DateTime    `json:"CreatedDate"`
+	UpdatedDate DateTime    `json:"UpdatedDate"`
+}
+
+// Project is a SuperOffice project
+type Project struct {
+	ProjectID     int      `json:"ProjectId"`
+	Name          string   `json:"Name"`
+	ProjectNumber string   `json:"ProjectNumber,omitempty"`
+	Description   string   `json:"Description,omitempty"`
+	Completed     bool     `json:"Completed"`
+	EndDate       DateTime `json:"EndDate"`
+	CreatedDate   DateTime `json:"CreatedDate"`
+	UpdatedDate   DateTime `json:"UpdatedDate"`
+}
+
+// Appointment is a calendar activity
+type Appointment struct {
+	AppointmentID int         `json:"AppointmentId"`
+	Description   string      `json:"Description"`
+	Location      string      `json:"Location,omitempty"`
+	StartDate     DateTime    `json:"StartDate"`
+	EndDate       DateTime    `json:"EndDate"`
+	Contact       *ContactRef `json:"Contact,omitempty"`
+	Person        *PersonRef  `json:"Person,omitempty"`
+	CreatedDate   DateTime    `json:"CreatedDate"`
+	UpdatedDate   DateTime    `json:"UpdatedDate"`
+}
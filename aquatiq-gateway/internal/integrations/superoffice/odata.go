@@ -0,0 +1,95 @@
+package superoffice
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Query holds OData query options for list requests
+type Query struct {
+	Filter  string   // $filter expression, e.g. Eq("name", "Aquatiq")
+	Select  []string // $select fields
+	OrderBy string   // $orderby, e.g. "updatedDate desc"
+	Top     int      // Page size ($top)
+	Skip    int      // Offset ($skip)
+}
+
+// Values encodes the query as URL parameters
+func (q Query) Values() url.Values {
+	values := url.Values{}
+	if q.Filter != "" {
+		values.Set("$filter", q.Filter)
+	}
+	if len(q.Select) > 0 {
+		values.Set("$select", strings.Join(q.Select, ","))
+	}
+	if q.OrderBy != "" {
+		values.Set("$orderby", q.OrderBy)
+	}
+	if q.Top > 0 {
+		values.Set("$top", strconv.Itoa(q.Top))
+	}
+	if q.Skip > 0 {
+		values.Set("$skip", strconv.Itoa(q.Skip))
+	}
+	return values
+}
+
+// Eq builds an equality filter
+func Eq(field string, value interface{}) string {
+	return fmt.Sprintf("%s eq %s", field, literal(value))
+}
+
+// Contains builds a substring filter
+func Contains(field, value string) string {
+	return fmt.Sprintf("%s contains %s", field, literal(value))
+}
+
+// After builds a filter for records changed after t (incremental syncs)
+func After(field string, t time.Time) string {
+	return fmt.Sprintf("%s afterTime %s", field, literal(t))
+}
+
+// And combines filters; empty filters are skipped
+func And(filters ...string) string {
+	return join(" and ", filters)
+}
+
+// Or combines filters; empty filters are skipped
+func Or(filters ...string) string {
+	return join(" or ", filters)
+}
+
+// join combines non-empty filters with an operator
+func join(op string, filters []string) string {
+	parts := make([]string, 0, len(filters))
+	for _, f := range filters {
+		if f != "" {
+			parts = append(parts, f)
+		}
+	}
+	if len(parts) == 1 {
+		return parts[0]
+	}
+	for i, p := range parts {
+		parts[i] = "(" + p + ")"
+	}
+	return strings.Join(parts, op)
+}
+
+// literal formats a value as an OData literal
+func literal(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return "'" + strings.ReplaceAll(v, "'", "''") + "'"
+	case time.Time:
+		return "'" + v.UTC().Format("2006-01-02T15:04:05") + "'"
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
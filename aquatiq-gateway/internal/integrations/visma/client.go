@@ -0,0 +1,359 @@
+package visma
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aquatiq/integration-gateway/internal/config"
+	"github.com/aquatiq/integration-gateway/internal/integrations"
+	"github.com/aquatiq/integration-gateway/pkg/httpclient"
+)
+
+// ServiceName identifies Visma.net in tokens, breakers and audit logs
+const ServiceName = "visma"
+
+// CompanyHeader selects the company a request operates on
+const CompanyHeader = "ipp-company-id"
+
+// defaultPageSize is used when a list request does not set a page size
+const defaultPageSize = 100
+
+// Client is a Visma.net ERP API client. One user can access several
+// companies, so every call names the company it operates on.
+type Client struct {
+	http           *httpclient.Client
+	cache          *integrations.ResponseCache // Nil when caching is disabled
+	baseURL        string
+	defaultCompany string
+
+	Customers     *Resource[Customer]
+	Suppliers     *Resource[Supplier]
+	SalesInvoices *Resource[SalesInvoice]
+	Inventory     *Resource[InventoryItem]
+	Projects      *Resource[Project]
+}
+
+// New creates a new Visma.net client with its own circuit breaker
+func New(cfg config.VismaConfig, deps integrations.Dependencies) (*Client, error) {
+	if cfg.BaseURL == "" {
+		return nil, fmt.Errorf("visma base URL is required")
+	}
+
+	settings := integrations.Settings{
+		Name:        ServiceName,
+		App:         cfg.ClientID,
+		Timeout:     cfg.Timeout,
+		RetryMax:    cfg.RetryMax,
+		Auth:        cfg.Auth,
+		Bulkhead:    cfg.Bulkhead,
+		RateLimit:   cfg.RateLimit,
+		RetryBudget: cfg.RetryBudget,
+		Hedge:       cfg.Hedge,
+		Idempotency: cfg.Idempotency,
+		Cassette:    cfg.Cassette,
+		Cache:       cfg.Cache,
+	}
+	c := &Client{
+		http:           integrations.NewHTTPClient(settings, deps),
+		cache:          integrations.NewResponseCache(settings, deps),
+		baseURL:        strings.TrimRight(cfg.BaseURL, "/") + "/controller/api/v1",
+		defaultCompany: cfg.CompanyID,
+	}
+
+	c.Customers = &Resource[Customer]{client: c, path: "customer"}
+	c.Suppliers = &Resource[Supplier]{client: c, path: "supplier"}
+	c.SalesInvoices = &Resource[SalesInvoice]{client: c, path: "customerinvoice"}
+	c.Inventory = &Resource[InventoryItem]{client: c, path: "inventory"}
+	c.Projects = &Resource[Project]{client: c, path: "project"}
+
+	return c, nil
+}
+
+// Stats returns HTTP client statistics
+func (c *Client) Stats() httpclient.Stats {
+	return c.http.Stats()
+}
+
+// CacheStats returns response cache statistics
+func (c *Client) CacheStats() integrations.CacheStats {
+	return c.cache.Stats()
+}
+
+// HTTP returns the resilient HTTP client, for forwarding raw API requests
+func (c *Client) HTTP() *httpclient.Client {
+	return c.http
+}
+
+// BaseURL returns the root of the Visma.net REST API
+func (c *Client) BaseURL() string {
+	return c.baseURL
+}
+
+// Ping performs the cheapest authenticated call, fetching one customer of
+// a company (the default company when empty), to check that the API is
+// reachable and the credentials can access the company
+func (c *Client) Ping(ctx context.Context, company string) error {
+	_, err := c.Customers.List(integrations.WithoutCache(ctx), company, ListOptions{PageSize: 1})
+	return err
+}
+
+// InvalidateFromWebhook drops cached copies of the entity a webhook event
+// reports as changed, e.g. "customer.updated" with the customer number.
+// Events without an entity key drop every cached response of the resource.
+func (c *Client) InvalidateFromWebhook(ctx context.Context, eventType string, payload []byte) error {
+	if c.cache == nil {
+		return nil
+	}
+
+	resource := c.eventResource(eventType)
+	if resource == "" {
+		resource = c.eventResource(integrations.PayloadField(payload, "resource", "entity", "entityType"))
+	}
+	if resource == "" {
+		return fmt.Errorf("visma event %q does not name a known resource", eventType)
+	}
+
+	company, err := c.companyID(integrations.PayloadField(payload, "companyId", "tenantId", "company"))
+	if err != nil {
+		return err
+	}
+
+	// "id" is the event ID, so only explicit entity keys are used
+	if key := integrations.PayloadField(payload, "resourceId", "entityId", "number", "key"); key != "" {
+		return c.cache.Invalidate(ctx, company, resource, key)
+	}
+	return c.cache.InvalidateResource(ctx, company, resource)
+}
+
+// ListOptions holds paging and filter options for list requests
+type ListOptions struct {
+	PageNumber    int               // 1-based page (default 1)
+	PageSize      int               // Items per page (default 100)
+	ModifiedSince time.Time         // Only items changed after this time (incremental syncs)
+	Params        map[string]string // Additional endpoint filters, e.g. "status": "Active"
+}
+
+// Page is one page of a list response
+type Page[T any] struct {
+	Items      []T
+	PageNumber int
+	HasMore    bool
+}
+
+// Resource provides operations for one entity type
+type Resource[T any] struct {
+	client *Client
+	path   string
+}
+
+// List returns one page of entities
+func (r *Resource[T]) List(ctx context.Context, company string, opts ListOptions) (*Page[T], error) {
+	if opts.PageNumber <= 0 {
+		opts.PageNumber = 1
+	}
+	if opts.PageSize <= 0 {
+		opts.PageSize = defaultPageSize
+	}
+
+	values := url.Values{}
+	values.Set("pageNumber", strconv.Itoa(opts.PageNumber))
+	values.Set("pageSize", strconv.Itoa(opts.PageSize))
+	if !opts.ModifiedSince.IsZero() {
+		values.Set("lastModifiedDateTime", opts.ModifiedSince.UTC().Format("2006-01-02T15:04:05"))
+		values.Set("lastModifiedDateTimeCondition", ">")
+	}
+	for key, value := range opts.Params {
+		values.Set(key, value)
+	}
+
+	var items []T
+	if err := r.client.get(ctx, company, r.client.baseURL+"/"+r.path+"?"+values.Encode(), &items); err != nil {
+		return nil, err
+	}
+
+	return &Page[T]{
+		Items:      items,
+		PageNumber: opts.PageNumber,
+		HasMore:    len(items) == opts.PageSize,
+	}, nil
+}
+
+// ListAll calls fn for every page of entities
+func (r *Resource[T]) ListAll(ctx context.Context, company string, opts ListOptions, fn func([]T) error) error {
+	for {
+		page, err := r.List(ctx, company, opts)
+		if err != nil {
+			return err
+		}
+		if err := fn(page.Items); err != nil {
+			return err
+		}
+		if !page.HasMore {
+			return nil
+		}
+		opts.PageNumber = page.PageNumber + 1
+	}
+}
+
+// Get returns one entity by its number or ID
+func (r *Resource[T]) Get(ctx context.Context, company, key string) (*T, error) {
+	var entity T
+	if err := r.client.get(ctx, company, r.entityURL(key), &entity); err != nil {
+		return nil, err
+	}
+	return &entity, nil
+}
+
+// Create creates an entity and returns it as stored. Visma.net only
+// returns the location of the new entity, so it is read back.
+func (r *Resource[T]) Create(ctx context.Context, company string, entity payload) (*T, error) {
+	resp, err := r.client.do(ctx, company, http.MethodPost, r.client.baseURL+"/"+r.path, entity.payload(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	location := resp.Header.Get("Location")
+	if location == "" {
+		return nil, fmt.Errorf("visma did not return the location of the created %s", r.path)
+	}
+	key, err := url.PathUnescape(path.Base(location))
+	if err != nil {
+		return nil, fmt.Errorf("invalid location %q: %w", location, err)
+	}
+	return r.Get(ctx, company, key)
+}
+
+// Update updates an entity and returns it as stored
+func (r *Resource[T]) Update(ctx context.Context, company, key string, entity payload) (*T, error) {
+	if _, err := r.client.do(ctx, company, http.MethodPut, r.entityURL(key), entity.payload(), nil); err != nil {
+		return nil, err
+	}
+	return r.Get(ctx, company, key)
+}
+
+// entityURL returns the URL of one entity
+func (r *Resource[T]) entityURL(key string) string {
+	return r.client.baseURL + "/" + r.path + "/" + url.PathEscape(key)
+}
+
+// companyID returns the company for a call, falling back to the configured default
+func (c *Client) companyID(company string) (string, error) {
+	if company != "" {
+		return company, nil
+	}
+	if c.defaultCompany != "" {
+		return c.defaultCompany, nil
+	}
+	return "", &Error{Kind: ErrValidation, Message: "company ID is required"}
+}
+
+// eventResource returns the resource a webhook event type refers to, e.g.
+// "customerinvoice" for "CustomerInvoice.Created", or "" when unknown
+func (c *Client) eventResource(eventType string) string {
+	name := strings.ToLower(eventType)
+
+	// Invoices first, so "customerinvoice" is not taken for "customer"
+	if strings.Contains(name, "invoice") {
+		return c.SalesInvoices.path
+	}
+	for _, resource := range []string{c.Customers.path, c.Suppliers.path, c.Inventory.path, c.Projects.path} {
+		if strings.Contains(name, resource) {
+			return resource
+		}
+	}
+	return ""
+}
+
+// get fetches a JSON resource for a company, from the response cache when possible
+func (c *Client) get(ctx context.Context, company, url string, out interface{}) error {
+	company, err := c.companyID(company)
+	if err != nil {
+		return err
+	}
+
+	resource, id := integrations.SplitResourceURL(c.baseURL, url)
+	body, err := c.cache.Fetch(ctx, company, resource, id, url, func() ([]byte, error) {
+		_, body, err := c.send(ctx, company, http.MethodGet, url, nil)
+		return body, err
+	})
+	if err != nil {
+		return err
+	}
+	return decode(body, out)
+}
+
+// do sends a JSON request for a company and decodes the JSON response into
+// out. Cached copies of the changed entity are dropped, even when the
+// request fails, since a timed-out write may still have been applied.
+func (c *Client) do(ctx context.Context, company, method, url string, in, out interface{}) (*http.Response, error) {
+	company, err := c.companyID(company)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, body, err := c.send(ctx, company, method, url, in)
+	if method != http.MethodGet {
+		resource, id := integrations.SplitResourceURL(c.baseURL, url)
+		// A failed invalidation is counted in the cache stats; entries expire with their TTL
+		_ = c.cache.Invalidate(ctx, company, resource, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return resp, decode(body, out)
+}
+
+// send sends a JSON request for a company and returns the response and its body
+func (c *Client) send(ctx context.Context, company, method, url string, in interface{}) (*http.Response, []byte, error) {
+	var body []byte
+	if in != nil {
+		var err error
+		if body, err = json.Marshal(in); err != nil {
+			return nil, nil, fmt.Errorf("failed to encode request: %w", err)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set(CompanyHeader, company)
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, nil, convertError(err, company)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read visma response: %w", err)
+	}
+	return resp, data, nil
+}
+
+// Helper functions
+
+// decode decodes a JSON response body into out; empty bodies are ignored
+func decode(body []byte, out interface{}) error {
+	if out == nil || len(body) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("failed to decode visma response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,257 @@
+package visma
+
+import (
+	"strings"
+	"time"
+)
+
+// DateTime is a Visma.net timestamp; the API often omits the time zone
+type DateTime struct {
+	time.Time
+}
+
+// dateTimeLayouts are the formats Visma.net uses for dates
+var dateTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02",
+}
+
+// UnmarshalJSON parses Visma.net dates (UTC when no zone is given)
+func (d *DateTime) UnmarshalJSON(data []byte) error {
+	value := strings.Trim(string(data), `"`)
+	if value == "" || value == "null" {
+		d.Time = time.Time{}
+		return nil
+	}
+
+	var err error
+	for _, layout := range dateTimeLayouts {
+		var t time.Time
+		if t, err = time.ParseInLocation(layout, value, time.UTC); err == nil {
+			d.Time = t
+			return nil
+		}
+	}
+	return err
+}
+
+// MarshalJSON formats dates the way Visma.net expects them
+func (d DateTime) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + d.UTC().Format("2006-01-02T15:04:05") + `"`), nil
+}
+
+// Country identifies a country
+type Country struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+// Address is a postal address
+type Address struct {
+	AddressLine1 string   `json:"addressLine1,omitempty"`
+	AddressLine2 string   `json:"addressLine2,omitempty"`
+	PostalCode   string   `json:"postalCode,omitempty"`
+	City         string   `json:"city,omitempty"`
+	Country      *Country `json:"country,omitempty"`
+}
+
+// Contact holds contact details of a customer or supplier
+type Contact struct {
+	Name   string `json:"name,omitempty"`
+	Email  string `json:"email,omitempty"`
+	Phone1 string `json:"phone1,omitempty"`
+}
+
+// Reference identifies a customer or supplier by number
+type Reference struct {
+	Number string `json:"number"`
+	Name   string `json:"name,omitempty"`
+}
+
+// Customer is a Visma.net customer
+type Customer struct {
+	InternalID           int      `json:"internalId"`
+	Number               string   `json:"number"`
+	Name                 string   `json:"name"`
+	Status               string   `json:"status"`
+	CorporateID          string   `json:"corporateId,omitempty"`
+	VatRegistrationID    string   `json:"vatRegistrationId,omitempty"`
+	CurrencyID           string   `json:"currencyId,omitempty"`
+	MainAddress          *Address `json:"mainAddress,omitempty"`
+	MainContact          *Contact `json:"mainContact,omitempty"`
+	LastModifiedDateTime DateTime `json:"lastModifiedDateTime"`
+}
+
+// Supplier is a Visma.net supplier
+type Supplier struct {
+	InternalID           int      `json:"internalId"`
+	Number               string   `json:"number"`
+	Name                 string   `json:"name"`
+	Status               string   `json:"status"`
+	VatRegistrationID    string   `json:"vatRegistrationId,omitempty"`
+	CurrencyID           string   `json:"currencyId,omitempty"`
+	MainAddress          *Address `json:"mainAddress,omitempty"`
+	MainContact          *Contact `json:"mainContact,omitempty"`
+	LastModifiedDateTime DateTime `json:"lastModifiedDateTime"`
+}
+
+// InvoiceLine is a line of a sales invoice
+type InvoiceLine struct {
+	LineNumber          int     `json:"lineNumber"`
+	InventoryNumber     string  `json:"inventoryNumber,omitempty"`
+	Description         string  `json:"description,omitempty"`
+	Quantity            float64 `json:"quantity"`
+	UnitPriceInCurrency float64 `json:"unitPriceInCurrency"`
+	AmountInCurrency    float64 `json:"amountInCurrency"`
+}
+
+// SalesInvoice is a customer invoice
+type SalesInvoice struct {
+	ReferenceNumber      string        `json:"referenceNumber"`
+	DocumentType         string        `json:"documentType,omitempty"`
+	Status               string        `json:"status,omitempty"`
+	Customer             *Reference    `json:"customer,omitempty"`
+	DocumentDate         DateTime      `json:"documentDate"`
+	DueDate              DateTime      `json:"dueDate"`
+	CurrencyID           string        `json:"currencyId,omitempty"`
+	Amount               float64       `json:"amount"`
+	Balance              float64       `json:"balance"`
+	VatTotal             float64       `json:"vatTotal"`
+	InvoiceLines         []InvoiceLine `json:"invoiceLines,omitempty"`
+	LastModifiedDateTime DateTime      `json:"lastModifiedDateTime"`
+}
+
+// InventoryItem is an inventory (article) item
+type InventoryItem struct {
+	InventoryID          int      `json:"inventoryId"`
+	InventoryNumber      string   `json:"inventoryNumber"`
+	Description          string   `json:"description"`
+	Status               string   `json:"status"`
+	Type                 string   `json:"type,omitempty"`
+	DefaultPrice         float64  `json:"defaultPrice"`
+	BaseUnit             string   `json:"baseUnit,omitempty"`
+	LastModifiedDateTime DateTime `json:"lastModifiedDateTime"`
+}
+
+// Project is a Visma.net project
+type Project struct {
+	InternalID           int        `json:"internalId"`
+	ProjectID            string     `json:"projectID"`
+	Description          string     `json:"description"`
+	Status               string     `json:"status"`
+	Customer             *Reference `json:"customer,omitempty"`
+	StartDate            DateTime   `json:"startDate"`
+	EndDate              DateTime   `json:"endDate"`
+	LastModifiedDateTime DateTime   `json:"lastModifiedDateTime"`
+}
+
+// Write payloads wrap every field as {"value": ...}
+
+// payload is implemented by entities that can be created or updated
+type payload interface {
+	payload() map[string]interface{}
+}
+
+// set adds a wrapped value unless it is empty
+func set(m map[string]interface{}, key string, v interface{}) {
+	switch typed := v.(type) {
+	case string:
+		if typed == "" {
+			return
+		}
+	case DateTime:
+		if typed.IsZero() {
+			return
+		}
+	case nil:
+		return
+	}
+	m[key] = map[string]interface{}{"value": v}
+}
+
+// addressPayload builds the write payload of an address
+func addressPayload(a *Address) map[string]interface{} {
+	m := map[string]interface{}{}
+	set(m, "addressLine1", a.AddressLine1)
+	set(m, "addressLine2", a.AddressLine2)
+	set(m, "postalCode", a.PostalCode)
+	set(m, "city", a.City)
+	if a.Country != nil {
+		set(m, "countryId", a.Country.ID)
+	}
+	return m
+}
+
+// contactPayload builds the write payload of contact details
+func contactPayload(c *Contact) map[string]interface{} {
+	m := map[string]interface{}{}
+	set(m, "name", c.Name)
+	set(m, "email", c.Email)
+	set(m, "phone1", c.Phone1)
+	return m
+}
+
+// payload builds the customer write payload
+func (c *Customer) payload() map[string]interface{} {
+	m := map[string]interface{}{}
+	set(m, "number", c.Number)
+	set(m, "name", c.Name)
+	set(m, "status", c.Status)
+	set(m, "corporateId", c.CorporateID)
+	set(m, "vatRegistrationId", c.VatRegistrationID)
+	set(m, "currencyId", c.CurrencyID)
+	if c.MainAddress != nil {
+		m["mainAddress"] = map[string]interface{}{"value": addressPayload(c.MainAddress)}
+	}
+	if c.MainContact != nil {
+		m["mainContact"] = map[string]interface{}{"value": contactPayload(c.MainContact)}
+	}
+	return m
+}
+
+// payload builds the supplier write payload
+func (s *Supplier) payload() map[string]interface{} {
+	m := map[string]interface{}{}
+	set(m, "number", s.Number)
+	set(m, "name", s.Name)
+	set(m, "status", s.Status)
+	set(m, "vatRegistrationId", s.VatRegistrationID)
+	set(m, "currencyId", s.CurrencyID)
+	if s.MainAddress != nil {
+		m["mainAddress"] = map[string]interface{}{"value": addressPayload(s.MainAddress)}
+	}
+	if s.MainContact != nil {
+		m["mainContact"] = map[string]interface{}{"value": contactPayload(s.MainContact)}
+	}
+	return m
+}
+
+// payload builds the sales invoice write payload
+func (i *SalesInvoice) payload() map[string]interface{} {
+	m := map[string]interface{}{}
+	set(m, "referenceNumber", i.ReferenceNumber)
+	if i.Customer != nil {
+		set(m, "customerNumber", i.Customer.Number)
+	}
+	set(m, "documentDate", i.DocumentDate)
+	set(m, "dueDate", i.DueDate)
+	set(m, "currencyId", i.CurrencyID)
+
+	lines := make([]map[string]interface{}, 0, len(i.InvoiceLines))
+	for _, line := range i.InvoiceLines {
+		l := map[string]interface{}{"operation": "Insert"}
+		set(l, "inventoryNumber", line.InventoryNumber)
+		set(l, "description", line.Description)
+		set(l, "quantity", line.Quantity)
+		set(l, "unitPriceInCurrency", line.UnitPriceInCurrency)
+		lines = append(lines, l)
+	}
+	if len(lines) > 0 {
+		m["invoiceLines"] = lines
+	}
+	return m
+}
@@ -0,0 +1,136 @@
+package visma
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/aquatiq/integration-gateway/pkg/httpclient"
+	"github.com/sony/gobreaker/v2"
+)
+
+// Error kinds; match them with errors.Is
+var (
+	ErrNotFound     = errors.New("visma: not found")
+	ErrUnauthorized = errors.New("visma: unauthorized")
+	ErrForbidden    = errors.New("visma: company not accessible")
+	ErrValidation   = errors.New("visma: validation failed")
+	ErrConflict     = errors.New("visma: conflict")
+	ErrRateLimited  = errors.New("visma: rate limited")
+	ErrUnavailable  = errors.New("visma: service unavailable")
+)
+
+// Error is a Visma.net API error
+type Error struct {
+	Kind       error  // One of the Err* kinds
+	StatusCode int    // HTTP status (0 if the request never got a response)
+	Code       string // Visma fault code, if any
+	Message    string
+	CompanyID  string
+}
+
+// Error implements the error interface
+func (e *Error) Error() string {
+	msg := e.Kind.Error()
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	if e.CompanyID != "" {
+		msg += fmt.Sprintf(" (company %s)", e.CompanyID)
+	}
+	return msg
+}
+
+// Unwrap returns the error kind
+func (e *Error) Unwrap() error {
+	return e.Kind
+}
+
+// apiError is the error body returned by Visma.net
+type apiError struct {
+	ExceptionType      string `json:"ExceptionType"`
+	ExceptionMessage   string `json:"ExceptionMessage"`
+	ExceptionFaultCode string `json:"ExceptionFaultCode"`
+	ExceptionMessageID string `json:"ExceptionMessageID"`
+	Message            string `json:"message"`
+}
+
+// convertError turns client errors into typed Visma errors
+func convertError(err error, companyID string) error {
+	var statusErr *httpclient.StatusError
+	switch {
+	case errors.As(err, &statusErr):
+		typed := &Error{
+			Kind:       kindForStatus(statusErr.StatusCode),
+			StatusCode: statusErr.StatusCode,
+			CompanyID:  companyID,
+		}
+
+		var body apiError
+		if json.Unmarshal(statusErr.Body, &body) == nil {
+			typed.Message = body.ExceptionMessage
+			if typed.Message == "" {
+				typed.Message = body.Message
+			}
+			typed.Code = body.ExceptionFaultCode
+			if typed.Code == "" {
+				typed.Code = body.ExceptionMessageID
+			}
+		}
+		return typed
+
+	case httpclient.IsRateLimited(err):
+		return &Error{Kind: ErrRateLimited, Message: err.Error(), CompanyID: companyID}
+
+	case errors.Is(err, gobreaker.ErrOpenState), errors.Is(err, gobreaker.ErrTooManyRequests), httpclient.IsSaturated(err):
+		return &Error{Kind: ErrUnavailable, Message: err.Error(), CompanyID: companyID}
+
+	default:
+		return err
+	}
+}
+
+// kindForStatus maps HTTP status codes to error kinds
+func kindForStatus(code int) error {
+	switch {
+	case code == http.StatusNotFound:
+		return ErrNotFound
+	case code == http.StatusUnauthorized:
+		return ErrUnauthorized
+	case code == http.StatusForbidden:
+		return ErrForbidden
+	case code == http.StatusConflict || code == http.StatusPreconditionFailed:
+		return ErrConflict
+	case code == http.StatusTooManyRequests:
+		return ErrRateLimited
+	case code >= 500:
+		return ErrUnavailable
+	default:
+		return ErrValidation
+	}
+}
+
+// ErrorCode returns a stable code for an error, for API responses
+func ErrorCode(err error) string {
+	switch {
+	case err == nil:
+		return ""
+	case errors.Is(err, ErrNotFound):
+		return "not_found"
+	case errors.Is(err, ErrUnauthorized):
+		return "unauthorized"
+	case errors.Is(err, ErrForbidden):
+		return "forbidden"
+	case errors.Is(err, ErrValidation):
+		return "validation_failed"
+	case errors.Is(err, ErrConflict):
+		return "conflict"
+	case errors.Is(err, ErrRateLimited):
+		return "rate_limited"
+	case errors.Is(err, ErrUnavailable):
+		return "unavailable"
+	default:
+		return "internal"
+	}
+}
@@ -0,0 +1,102 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aquatiq/integration-gateway/internal/config"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// JetStream is a NATS connection used to publish to durable JetStream streams
+type JetStream struct {
+	conn *nats.Conn
+	js   jetstream.JetStream
+}
+
+// Connect connects to NATS; reconnects are handled by the client
+func Connect(cfg config.NATSConfig) (*JetStream, error) {
+	if cfg.URL == "" {
+		return nil, fmt.Errorf("nats.url is required")
+	}
+
+	opts := []nats.Option{
+		nats.Name(cfg.Name),
+		nats.MaxReconnects(-1),
+		nats.ReconnectWait(2 * time.Second),
+		nats.Timeout(cfg.Timeout),
+	}
+	if cfg.CredsFile != "" {
+		opts = append(opts, nats.UserCredentials(cfg.CredsFile))
+	}
+	if cfg.Token != "" {
+		opts = append(opts, nats.Token(cfg.Token))
+	}
+
+	conn, err := nats.Connect(cfg.URL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to open JetStream: %w", err)
+	}
+
+	return &JetStream{conn: conn, js: js}, nil
+}
+
+// EnsureStream creates a file-backed stream or updates its subjects and retention
+func (j *JetStream) EnsureStream(ctx context.Context, name string, subjects []string, maxAge time.Duration) error {
+	_, err := j.js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:       name,
+		Subjects:   subjects,
+		Storage:    jetstream.FileStorage,
+		MaxAge:     maxAge,
+		Duplicates: 2 * time.Minute, // Window in which repeated message IDs are dropped
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create stream %s: %w", name, err)
+	}
+	return nil
+}
+
+// Publish stores a message and waits for the server to acknowledge it. Messages
+// with an ID already published within the stream's duplicate window are dropped.
+func (j *JetStream) Publish(ctx context.Context, subject, msgID string, data []byte, header map[string]string) error {
+	msg := nats.NewMsg(subject)
+	msg.Data = data
+	for name, value := range header {
+		msg.Header.Set(name, value)
+	}
+
+	var opts []jetstream.PublishOpt
+	if msgID != "" {
+		opts = append(opts, jetstream.WithMsgID(msgID))
+	}
+	if _, err := j.js.PublishMsg(ctx, msg, opts...); err != nil {
+		if errors.Is(err, jetstream.ErrNoStreamResponse) {
+			return fmt.Errorf("no stream accepts subject %s: %w", subject, err)
+		}
+		return fmt.Errorf("failed to publish to %s: %w", subject, err)
+	}
+	return nil
+}
+
+// Stream returns the JetStream API, e.g. to consume from a stream
+func (j *JetStream) Stream() jetstream.JetStream {
+	return j.js
+}
+
+// Connected reports whether the NATS connection is up
+func (j *JetStream) Connected() bool {
+	return j.conn.IsConnected()
+}
+
+// Close drains pending messages and closes the connection
+func (j *JetStream) Close() {
+	_ = j.conn.Drain()
+}
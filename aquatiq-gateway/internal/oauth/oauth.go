@@ -0,0 +1,402 @@
+package oauth
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aquatiq/integration-gateway/internal/audit"
+	"github.com/aquatiq/integration-gateway/internal/auth"
+	"github.com/aquatiq/integration-gateway/internal/cache"
+	"github.com/aquatiq/integration-gateway/internal/config"
+	"github.com/go-chi/chi/v5"
+)
+
+// stateCookie binds a pending authorization to the browser that started it
+const stateCookie = "aquatiq_oauth_state"
+
+// tenantPattern limits tenant and user identifiers, which become part of token keys
+var tenantPattern = regexp.MustCompile(`^[A-Za-z0-9._@-]{1,128}$`)
+
+// Config holds OAuth2 authorization-code flow configuration
+type Config struct {
+	Providers   map[string]config.OAuthProviderConfig
+	StateTTL    time.Duration      // How long a start link and then the authorization stay valid
+	Tokens      *auth.TokenManager // Stores and refreshes the resulting tokens
+	Cache       *cache.RedisCache  // Optional; pending authorizations are kept in memory without it
+	AuditLogger *audit.AuditLogger
+}
+
+// Handler implements the OAuth2 authorization-code flow with PKCE (RFC 7636)
+// so users can connect SuperOffice and Visma.net to the gateway
+type Handler struct {
+	providers  map[string]Provider
+	configured []string
+	tokens     *auth.TokenManager
+	starts     *stateStore // Authorizations waiting for their start link to be opened
+	states     *stateStore // Authorizations waiting for the provider's callback
+	stateTTL   time.Duration
+	audit      *audit.AuditLogger
+}
+
+// ProviderStatus describes whether a provider is connected
+type ProviderStatus struct {
+	Provider    string             `json:"provider"`
+	Enabled     bool               `json:"enabled"`
+	Connected   bool               `json:"connected"`
+	Connections []auth.TokenStatus `json:"connections"`
+	Error       string             `json:"error,omitempty"`
+}
+
+// New creates a new OAuth2 handler. Enabled providers are registered with the
+// token manager so their tokens can be refreshed.
+func New(cfg Config) *Handler {
+	if cfg.StateTTL == 0 {
+		cfg.StateTTL = 10 * time.Minute
+	}
+
+	h := &Handler{
+		providers: make(map[string]Provider),
+		tokens:    cfg.Tokens,
+		starts:    newStateStore(cfg.Cache, "oauth:start:"),
+		states:    newStateStore(cfg.Cache, "oauth:state:"),
+		stateTTL:  cfg.StateTTL,
+		audit:     cfg.AuditLogger,
+	}
+
+	for name, providerCfg := range cfg.Providers {
+		h.configured = append(h.configured, name)
+		if !providerCfg.Enabled || providerCfg.ClientID == "" || providerCfg.RedirectURL == "" {
+			continue
+		}
+
+		provider := newProvider(name, providerCfg)
+		h.providers[name] = provider
+		if !h.tokens.HasProvider(name) {
+			h.tokens.RegisterProvider(auth.ProviderConfig{
+				Name:         name,
+				TokenURL:     provider.TokenURL,
+				ClientID:     provider.ClientID,
+				ClientSecret: provider.ClientSecret,
+				Scopes:       provider.Scopes,
+			})
+		}
+	}
+	sort.Strings(h.configured)
+
+	return h
+}
+
+// Enabled returns the names of the providers users can connect
+func (h *Handler) Enabled() []string {
+	names := make([]string, 0, len(h.providers))
+	for name := range h.providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Authorize prepares an authorization and returns a single-use start URL for
+// the user to open in their browser. The optional tenant query parameter
+// stores the token for one tenant or user; without it the token is used by the
+// gateway's own integration clients. The route must sit behind API key
+// authentication (oauth:connect).
+func (h *Handler) Authorize(w http.ResponseWriter, r *http.Request) {
+	provider, ok := h.providers[chi.URLParam(r, "provider")]
+	if !ok {
+		h.respondError(w, http.StatusNotFound, "unknown or disabled OAuth provider")
+		return
+	}
+
+	tenant := r.URL.Query().Get("tenant")
+	if tenant != "" && !tenantPattern.MatchString(tenant) {
+		h.respondError(w, http.StatusBadRequest, "invalid tenant")
+		return
+	}
+
+	token, err := randomString(32)
+	if err != nil {
+		h.respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	pending := authorization{
+		Provider:    provider.Name,
+		Tenant:      tenant,
+		RedirectURL: provider.RedirectURL,
+		ExpiresAt:   time.Now().Add(h.stateTTL),
+	}
+	if err := h.starts.save(r.Context(), token, pending); err != nil {
+		h.respondError(w, http.StatusServiceUnavailable, err.Error())
+		return
+	}
+
+	startURL, err := startURL(provider, token)
+	if err != nil {
+		h.respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if h.audit != nil {
+		h.audit.LogHTTPRequest(r, "oauth_authorize", true, nil, 0)
+	}
+
+	h.respondJSON(w, http.StatusOK, map[string]interface{}{
+		"start_url":  startURL,
+		"expires_at": pending.ExpiresAt,
+	})
+}
+
+// Start opens an authorization in the user's browser: it takes the start
+// token issued by Authorize, binds the state to the browser with a cookie and
+// redirects to the provider. The token is all a browser needs, so it works once.
+func (h *Handler) Start(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	provider, ok := h.providers[chi.URLParam(r, "provider")]
+	if !ok {
+		h.respondError(w, http.StatusNotFound, "unknown or disabled OAuth provider")
+		return
+	}
+
+	pending, err := h.starts.take(r.Context(), r.URL.Query().Get("token"))
+	if errors.Is(err, errUnknownState) {
+		err = errors.New("start link is unknown, used or expired")
+	}
+	if err != nil {
+		h.fail(w, r, "oauth_start", http.StatusBadRequest, err, start)
+		return
+	}
+	if pending.Provider != provider.Name {
+		h.fail(w, r, "oauth_start", http.StatusBadRequest, errors.New("start link was issued for another provider"), start)
+		return
+	}
+
+	state, err := randomString(32)
+	if err != nil {
+		h.respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	verifier, err := randomString(32)
+	if err != nil {
+		h.respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	binding, err := randomString(32)
+	if err != nil {
+		h.respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	pending.CodeVerifier = verifier
+	pending.Binding = bindingDigest(binding)
+	pending.ExpiresAt = time.Now().Add(h.stateTTL)
+	if err := h.states.save(r.Context(), state, *pending); err != nil {
+		h.respondError(w, http.StatusServiceUnavailable, err.Error())
+		return
+	}
+
+	// Only the browser holding the cookie can complete this authorization
+	http.SetCookie(w, &http.Cookie{
+		Name:     stateCookie,
+		Value:    binding,
+		Path:     callbackPath(provider),
+		Expires:  pending.ExpiresAt,
+		MaxAge:   int(h.stateTTL.Seconds()),
+		Secure:   strings.HasPrefix(provider.RedirectURL, "https://"),
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	params := url.Values{}
+	params.Set("response_type", "code")
+	params.Set("client_id", provider.ClientID)
+	params.Set("redirect_uri", pending.RedirectURL)
+	params.Set("scope", strings.Join(provider.Scopes, " "))
+	params.Set("state", state)
+	params.Set("code_challenge", codeChallenge(verifier))
+	params.Set("code_challenge_method", "S256")
+
+	location := provider.AuthorizeURL
+	if strings.Contains(location, "?") {
+		location += "&" + params.Encode()
+	} else {
+		location += "?" + params.Encode()
+	}
+
+	if h.audit != nil {
+		h.audit.LogHTTPRequest(r, "oauth_start", true, nil, time.Since(start))
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	http.Redirect(w, r, location, http.StatusFound)
+}
+
+// Callback completes an authorization by exchanging the code for tokens
+func (h *Handler) Callback(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	name := chi.URLParam(r, "provider")
+	provider, ok := h.providers[name]
+	if !ok {
+		h.respondError(w, http.StatusNotFound, "unknown or disabled OAuth provider")
+		return
+	}
+
+	cookie, err := r.Cookie(stateCookie)
+	if err != nil || cookie.Value == "" {
+		h.fail(w, r, "oauth_callback", http.StatusBadRequest, errors.New("authorization was not started in this browser"), start)
+		return
+	}
+	clearStateCookie(w, provider)
+
+	query := r.URL.Query()
+	pending, err := h.states.take(r.Context(), query.Get("state"))
+	if err != nil {
+		h.fail(w, r, "oauth_callback", http.StatusBadRequest, err, start)
+		return
+	}
+	if pending.Provider != provider.Name {
+		h.fail(w, r, "oauth_callback", http.StatusBadRequest, errors.New("state was issued for another provider"), start)
+		return
+	}
+	if subtle.ConstantTimeCompare([]byte(pending.Binding), []byte(bindingDigest(cookie.Value))) != 1 {
+		h.fail(w, r, "oauth_callback", http.StatusBadRequest, errors.New("state was issued to another browser"), start)
+		return
+	}
+
+	// The user declined or the provider rejected the request
+	if providerErr := query.Get("error"); providerErr != "" {
+		if desc := query.Get("error_description"); desc != "" {
+			providerErr += ": " + desc
+		}
+		h.fail(w, r, "oauth_callback", http.StatusBadRequest, fmt.Errorf("authorization denied: %s", providerErr), start)
+		return
+	}
+
+	code := query.Get("code")
+	if code == "" {
+		h.fail(w, r, "oauth_callback", http.StatusBadRequest, errors.New("authorization code is missing"), start)
+		return
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", pending.RedirectURL)
+	form.Set("client_id", provider.ClientID)
+	form.Set("client_secret", provider.ClientSecret)
+	form.Set("code_verifier", pending.CodeVerifier)
+
+	token, err := h.tokens.Exchange(r.Context(), provider.TokenURL, form)
+	if err != nil {
+		h.fail(w, r, "oauth_callback", http.StatusBadGateway, err, start)
+		return
+	}
+
+	key := auth.TokenKey(provider.Name, pending.Tenant)
+	if err := h.tokens.SetToken(key, *token); err != nil {
+		h.fail(w, r, "oauth_callback", http.StatusServiceUnavailable, err, start)
+		return
+	}
+
+	if h.audit != nil {
+		h.audit.LogHTTPRequest(r, "oauth_callback", true, nil, time.Since(start))
+	}
+
+	h.respondJSON(w, http.StatusOK, map[string]interface{}{
+		"success":     true,
+		"provider":    provider.Name,
+		"tenant":      pending.Tenant,
+		"expires_at":  token.ExpiresAt,
+		"refreshable": token.RefreshToken != "",
+		"scope":       token.Scope,
+		"token_key":   key,
+	})
+}
+
+// Status shows which providers and tenants are connected. The route must sit
+// behind API key authentication (oauth:read), as it lists tenant identifiers.
+func (h *Handler) Status(w http.ResponseWriter, r *http.Request) {
+	statuses := make([]ProviderStatus, 0, len(h.configured))
+	for _, name := range h.configured {
+		_, enabled := h.providers[name]
+		status := ProviderStatus{Provider: name, Enabled: enabled, Connections: []auth.TokenStatus{}}
+
+		connections, err := h.tokens.Connections(name)
+		if err != nil {
+			status.Error = err.Error()
+		} else {
+			status.Connections = connections
+			status.Connected = len(connections) > 0
+		}
+		statuses = append(statuses, status)
+	}
+
+	h.respondJSON(w, http.StatusOK, map[string]interface{}{
+		"providers": statuses,
+	})
+}
+
+// Helper functions
+
+// startURL is the start link of a pending authorization. It is on the host of
+// the configured redirect URL, so the state cookie reaches the callback.
+func startURL(provider Provider, token string) (string, error) {
+	u, err := url.Parse(provider.RedirectURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid redirect URL for %s: %w", provider.Name, err)
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/callback") + "/start"
+	u.RawPath = ""
+	u.RawQuery = url.Values{"token": {token}}.Encode()
+	u.Fragment = ""
+	return u.String(), nil
+}
+
+// callbackPath is the path of a provider's callback endpoint, which scopes the state cookie
+func callbackPath(provider Provider) string {
+	return "/oauth/" + provider.Name + "/callback"
+}
+
+// clearStateCookie removes the state cookie once a callback has used it
+func clearStateCookie(w http.ResponseWriter, provider Provider) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     stateCookie,
+		Value:    "",
+		Path:     callbackPath(provider),
+		MaxAge:   -1,
+		Secure:   strings.HasPrefix(provider.RedirectURL, "https://"),
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// fail audits a failed start or callback and responds with the error
+func (h *Handler) fail(w http.ResponseWriter, r *http.Request, action string, status int, err error, start time.Time) {
+	if h.audit != nil {
+		h.audit.LogHTTPRequest(r, action, false, err, time.Since(start))
+	}
+	h.respondError(w, status, err.Error())
+}
+
+// respondError writes a JSON error response
+func (h *Handler) respondError(w http.ResponseWriter, status int, message string) {
+	h.respondJSON(w, status, map[string]string{
+		"error": message,
+	})
+}
+
+// respondJSON writes a JSON response
+func (h *Handler) respondJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
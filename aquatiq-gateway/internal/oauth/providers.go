@@ -0,0 +1,64 @@
+package oauth
+
+import "github.com/aquatiq/integration-gateway/internal/config"
+
+// endpoints are the documented OAuth2 endpoints and scopes of a provider
+type endpoints struct {
+	AuthorizeURL string
+	TokenURL     string
+	Scopes       []string
+}
+
+// knownProviders fills in what the config leaves out
+var knownProviders = map[string]endpoints{
+	// Access tokens last 1 hour, refresh tokens 90 days
+	"superoffice": {
+		AuthorizeURL: "https://sod.superoffice.com/login/common/oauth/authorize",
+		TokenURL:     "https://sod.superoffice.com/login/common/oauth/tokens",
+		Scopes:       []string{"openid", "profile", "WebAPI"},
+	},
+	// offline_access is required to receive a refresh token
+	"visma": {
+		AuthorizeURL: "https://connect.visma.com/connect/authorize",
+		TokenURL:     "https://connect.visma.com/connect/token",
+		Scopes:       []string{"offline_access", "financials", "projects", "customers", "inventory", "sales"},
+	},
+}
+
+// Provider is an OAuth2 provider that users can connect through the authorization-code flow
+type Provider struct {
+	Name         string
+	AuthorizeURL string
+	TokenURL     string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string // Registered with the provider; never derived from the request
+	Scopes       []string
+}
+
+// newProvider builds a provider from its config, using the documented endpoints as defaults
+func newProvider(name string, cfg config.OAuthProviderConfig) Provider {
+	p := Provider{
+		Name:         name,
+		AuthorizeURL: cfg.AuthorizeURL,
+		TokenURL:     cfg.TokenURL,
+		ClientID:     cfg.ClientID,
+		ClientSecret: cfg.ClientSecret,
+		RedirectURL:  cfg.RedirectURL,
+		Scopes:       cfg.Scopes,
+	}
+
+	if known, ok := knownProviders[name]; ok {
+		if p.AuthorizeURL == "" {
+			p.AuthorizeURL = known.AuthorizeURL
+		}
+		if p.TokenURL == "" {
+			p.TokenURL = known.TokenURL
+		}
+		if len(p.Scopes) == 0 {
+			p.Scopes = known.Scopes
+		}
+	}
+
+	return p
+}
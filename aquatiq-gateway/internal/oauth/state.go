@@ -0,0 +1,124 @@
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aquatiq/integration-gateway/internal/cache"
+	"github.com/redis/go-redis/v9"
+)
+
+// errUnknownState is returned for states that were never issued, already used or expired
+var errUnknownState = errors.New("unknown or expired state")
+
+// authorization is a pending authorization, stored under its start token
+// until the start link is opened and then under its state parameter
+type authorization struct {
+	Provider     string    `json:"provider"`
+	Tenant       string    `json:"tenant,omitempty"`
+	CodeVerifier string    `json:"code_verifier,omitempty"`
+	RedirectURL  string    `json:"redirect_url"`
+	Binding      string    `json:"binding,omitempty"` // Digest of the state cookie given to the browser that opened the start link
+	ExpiresAt    time.Time `json:"expires_at"`
+}
+
+// stateStore keeps pending authorizations in Redis, or in memory without it.
+// Each key can be taken once, so neither a start link nor a callback can be replayed.
+type stateStore struct {
+	cache  *cache.RedisCache
+	local  map[string]authorization
+	prefix string
+	mu     sync.Mutex
+}
+
+// newStateStore creates a state store keeping its entries under prefix
+func newStateStore(c *cache.RedisCache, prefix string) *stateStore {
+	return &stateStore{
+		cache:  c,
+		local:  make(map[string]authorization),
+		prefix: prefix,
+	}
+}
+
+// save stores a pending authorization until it expires
+func (s *stateStore) save(ctx context.Context, state string, auth authorization) error {
+	if s.cache != nil {
+		data, err := json.Marshal(auth)
+		if err != nil {
+			return fmt.Errorf("failed to encode state: %w", err)
+		}
+		return s.cache.Client().Set(ctx, s.prefix+state, data, time.Until(auth.ExpiresAt)).Err()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Drop abandoned authorizations
+	now := time.Now()
+	for key, pending := range s.local {
+		if now.After(pending.ExpiresAt) {
+			delete(s.local, key)
+		}
+	}
+	s.local[state] = auth
+	return nil
+}
+
+// take removes and returns a pending authorization
+func (s *stateStore) take(ctx context.Context, state string) (*authorization, error) {
+	var auth authorization
+	if s.cache != nil {
+		data, err := s.cache.Client().GetDel(ctx, s.prefix+state).Bytes()
+		if err == redis.Nil {
+			return nil, errUnknownState
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to load state: %w", err)
+		}
+		if err := json.Unmarshal(data, &auth); err != nil {
+			return nil, fmt.Errorf("failed to decode state: %w", err)
+		}
+	} else {
+		s.mu.Lock()
+		pending, ok := s.local[state]
+		delete(s.local, state)
+		s.mu.Unlock()
+		if !ok {
+			return nil, errUnknownState
+		}
+		auth = pending
+	}
+
+	if time.Now().After(auth.ExpiresAt) {
+		return nil, errUnknownState
+	}
+	return &auth, nil
+}
+
+// randomString returns a URL-safe random string of n random bytes
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate random value: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// bindingDigest derives what is stored of a state cookie, so the store never holds the cookie itself
+func bindingDigest(binding string) string {
+	sum := sha256.Sum256([]byte(binding))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// codeChallenge derives the S256 PKCE challenge of a verifier (RFC 7636)
+func codeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
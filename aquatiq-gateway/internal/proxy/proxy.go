@@ -0,0 +1,221 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aquatiq/integration-gateway/internal/audit"
+	"github.com/aquatiq/integration-gateway/internal/auth"
+	"github.com/aquatiq/integration-gateway/internal/config"
+	"github.com/aquatiq/integration-gateway/pkg/httpclient"
+	"github.com/go-chi/chi/v5"
+	"github.com/sony/gobreaker/v2"
+)
+
+// Target is an integration the proxy forwards to
+type Target struct {
+	BaseURL string             // Root of the provider's REST API
+	Client  *httpclient.Client // Resilient client with the integration's token, breaker, retries and rate limit
+}
+
+// Config holds integration proxy configuration
+type Config struct {
+	Targets      map[string]Target
+	Rules        []config.ProxyRule
+	MaxBodyBytes int64
+	AuditLogger  *audit.AuditLogger
+}
+
+// Proxy forwards /integrations/{service}/* to the provider's API so internal
+// tools can reach it without holding provider credentials. Requests must be
+// allowed by a rule for one of the API key's scopes.
+type Proxy struct {
+	targets      map[string]Target
+	rules        []rule
+	maxBodyBytes int64
+	audit        *audit.AuditLogger
+}
+
+// New creates a new integration proxy
+func New(cfg Config) *Proxy {
+	if cfg.MaxBodyBytes <= 0 {
+		cfg.MaxBodyBytes = 10 << 20
+	}
+
+	targets := make(map[string]Target, len(cfg.Targets))
+	for name, target := range cfg.Targets {
+		target.BaseURL = strings.TrimRight(target.BaseURL, "/")
+		targets[name] = target
+	}
+
+	return &Proxy{
+		targets:      targets,
+		rules:        compileRules(cfg.Rules),
+		maxBodyBytes: cfg.MaxBodyBytes,
+		audit:        cfg.AuditLogger,
+	}
+}
+
+// Services returns the names of the integrations that can be proxied
+func (p *Proxy) Services() []string {
+	names := make([]string, 0, len(p.targets))
+	for name := range p.targets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Authorize rejects requests the API key's scopes do not allow before they
+// reach the handlers mounted after it, such as idempotent replays. It expects
+// the {service} route parameter and must run behind the API key middleware.
+func (p *Proxy) Authorize(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, _, ok := p.authorize(w, r); ok {
+			next.ServeHTTP(w, r)
+		}
+	})
+}
+
+// ServeHTTP forwards a request; it expects the {service} route parameter
+// and must run behind the API key middleware
+func (p *Proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	service := chi.URLParam(r, "service")
+
+	target, escaped, ok := p.authorize(w, r)
+	if !ok {
+		return
+	}
+
+	// Buffer the body so retries can resend it
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, p.maxBodyBytes))
+	if err != nil {
+		p.respondError(w, http.StatusRequestEntityTooLarge, "body_too_large", "request body is too large")
+		return
+	}
+
+	// The gateway's own API key must not reach the provider
+	query := r.URL.Query()
+	query.Del("api_key")
+	upstreamURL := target.BaseURL + escaped
+	if encoded := query.Encode(); encoded != "" {
+		upstreamURL += "?" + encoded
+	}
+
+	// The caller sees the failure and decides whether to retry, so proxied
+	// writes are not kept as dead letters. Calls go out with the integration's
+	// own token, so the provider bills them to its user, not the API key's.
+	ctx := httpclient.WithoutDeadLetter(r.Context())
+	req, err := http.NewRequestWithContext(ctx, r.Method, upstreamURL, bytes.NewReader(body))
+	if err != nil {
+		p.respondError(w, http.StatusBadRequest, "invalid_request", err.Error())
+		return
+	}
+	req.Header = filterHeaders(r.Header, sensitiveRequestHeaders)
+
+	resp, err := target.Client.Do(req)
+
+	var statusErr *httpclient.StatusError
+	switch {
+	case err == nil:
+		defer resp.Body.Close()
+		responseBody, _ := io.ReadAll(resp.Body)
+		p.respond(w, resp.StatusCode, resp.Header, responseBody)
+	case errors.As(err, &statusErr):
+		// Provider errors are passed through as they are
+		p.respond(w, statusErr.StatusCode, statusErr.Header, statusErr.Body)
+	default:
+		status, code := errorStatus(err)
+		p.respondError(w, status, code, err.Error())
+	}
+
+	if p.audit != nil {
+		p.audit.LogHTTPRequest(r, "integration_proxy_"+service, err == nil, err, time.Since(start))
+	}
+}
+
+// errorStatus maps client errors that never reached the provider to a response status
+func errorStatus(err error) (int, string) {
+	var authErr *httpclient.AuthError
+	switch {
+	case httpclient.IsRateLimited(err):
+		return http.StatusTooManyRequests, "rate_limited"
+	case httpclient.IsSaturated(err), errors.Is(err, gobreaker.ErrOpenState), errors.Is(err, gobreaker.ErrTooManyRequests):
+		return http.StatusServiceUnavailable, "unavailable"
+	case errors.As(err, &authErr):
+		return http.StatusBadGateway, "provider_auth_failed"
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout, "timeout"
+	default:
+		return http.StatusBadGateway, "upstream_error"
+	}
+}
+
+// Helper functions
+
+// authorize resolves the target and escaped upstream path of a request and
+// checks the API key may call it; it responds itself when it may not
+func (p *Proxy) authorize(w http.ResponseWriter, r *http.Request) (Target, string, bool) {
+	service := chi.URLParam(r, "service")
+
+	target, ok := p.targets[service]
+	if !ok {
+		p.respondError(w, http.StatusNotFound, "unknown_integration", fmt.Sprintf("integration %q is not available", service))
+		return Target{}, "", false
+	}
+
+	key, ok := auth.APIKeyFromContext(r.Context())
+	if !ok {
+		p.respondError(w, http.StatusUnauthorized, "unauthorized", "API key is required")
+		return Target{}, "", false
+	}
+
+	// Work on the escaped path so encoded characters reach the provider unchanged
+	escaped := strings.TrimPrefix(r.URL.EscapedPath(), "/integrations/"+service)
+	if escaped == "" {
+		escaped = "/"
+	}
+	upstreamPath, err := url.PathUnescape(escaped)
+	if err != nil || !validPath(upstreamPath) {
+		p.respondError(w, http.StatusBadRequest, "invalid_path", "invalid integration path")
+		return Target{}, "", false
+	}
+
+	if !allowed(p.rules, key, service, r.Method, upstreamPath) {
+		if p.audit != nil {
+			p.audit.LogAuthFailure(r, "proxy_not_allowed")
+		}
+		p.respondError(w, http.StatusForbidden, "forbidden", fmt.Sprintf("%s %s is not allowed for this API key", r.Method, upstreamPath))
+		return Target{}, "", false
+	}
+	return target, escaped, true
+}
+
+// respond writes an upstream response without hop-by-hop and sensitive headers
+func (p *Proxy) respond(w http.ResponseWriter, status int, header http.Header, body []byte) {
+	for name, values := range filterHeaders(header, sensitiveResponseHeaders) {
+		w.Header()[name] = values
+	}
+	w.WriteHeader(status)
+	w.Write(body)
+}
+
+// respondError writes a JSON error response
+func (p *Proxy) respondError(w http.ResponseWriter, status int, code, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{
+		"error":   code,
+		"message": message,
+	})
+}
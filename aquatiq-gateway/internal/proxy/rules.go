@@ -0,0 +1,158 @@
+package proxy
+
+import (
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/aquatiq/integration-gateway/internal/auth"
+	"github.com/aquatiq/integration-gateway/internal/config"
+)
+
+// rule is a compiled allowlist entry
+type rule struct {
+	scope     string
+	service   string
+	anyMethod bool
+	methods   map[string]bool
+	paths     [][]string // Patterns split into segments
+}
+
+// compileRules prepares the allowlist
+func compileRules(rules []config.ProxyRule) []rule {
+	compiled := make([]rule, 0, len(rules))
+	for _, r := range rules {
+		c := rule{scope: r.Scope, service: r.Service, methods: make(map[string]bool)}
+		for _, method := range r.Methods {
+			if method == "*" {
+				c.anyMethod = true
+			}
+			c.methods[strings.ToUpper(method)] = true
+		}
+		for _, pattern := range r.Paths {
+			c.paths = append(c.paths, segments(pattern))
+		}
+		compiled = append(compiled, c)
+	}
+	return compiled
+}
+
+// allowed reports whether any rule lets the key call method on path of service
+func allowed(rules []rule, key auth.APIKey, service, method, p string) bool {
+	requested := segments(p)
+	for _, r := range rules {
+		if r.service != "*" && r.service != service {
+			continue
+		}
+		if !key.HasScope(r.scope) {
+			continue
+		}
+		if !r.anyMethod && !r.methods[method] {
+			continue
+		}
+		for _, pattern := range r.paths {
+			if matchSegments(pattern, requested) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchSegments matches a path against a pattern; * matches one segment
+// (path.Match syntax) and a trailing ** matches any remaining segments
+func matchSegments(pattern, p []string) bool {
+	for i, seg := range pattern {
+		if seg == "**" && i == len(pattern)-1 {
+			return true
+		}
+		if i >= len(p) {
+			return false
+		}
+		if ok, err := path.Match(seg, p[i]); err != nil || !ok {
+			return false
+		}
+	}
+	return len(p) == len(pattern)
+}
+
+// segments splits a path into its segments
+func segments(p string) []string {
+	p = strings.Trim(p, "/")
+	if p == "" {
+		return nil
+	}
+	return strings.Split(p, "/")
+}
+
+// validPath rejects paths with empty or dot segments, so a path cannot
+// match a rule and then resolve to somewhere else upstream
+func validPath(p string) bool {
+	if !strings.HasPrefix(p, "/") {
+		return false
+	}
+	for _, seg := range strings.Split(strings.TrimPrefix(p, "/"), "/") {
+		if seg == "." || seg == ".." {
+			return false
+		}
+	}
+	return !strings.Contains(p, "//")
+}
+
+// hopByHopHeaders apply to a single connection and are never forwarded (RFC 9110 7.6.1)
+var hopByHopHeaders = []string{
+	"Connection",
+	"Proxy-Connection",
+	"Keep-Alive",
+	"Proxy-Authenticate",
+	"Proxy-Authorization",
+	"Te",
+	"Trailer",
+	"Transfer-Encoding",
+	"Upgrade",
+}
+
+// sensitiveRequestHeaders carry the caller's credentials or network details
+var sensitiveRequestHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"X-API-Key",
+	"Forwarded",
+	"X-Forwarded-For",
+	"X-Forwarded-Host",
+	"X-Forwarded-Proto",
+	"X-Real-IP",
+	"Accept-Encoding", // Let the transport negotiate and decompress
+	"Content-Length",
+}
+
+// sensitiveResponseHeaders are provider session details callers must not see
+var sensitiveResponseHeaders = []string{
+	"Set-Cookie",
+	"WWW-Authenticate",
+	"Content-Length",
+}
+
+// filterHeaders copies h without hop-by-hop headers and the given sensitive headers
+func filterHeaders(h http.Header, sensitive []string) http.Header {
+	out := h.Clone()
+	if out == nil {
+		return http.Header{}
+	}
+
+	// Headers named in Connection are hop-by-hop too
+	for _, value := range out.Values("Connection") {
+		for _, name := range strings.Split(value, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				out.Del(name)
+			}
+		}
+	}
+	for _, name := range hopByHopHeaders {
+		out.Del(name)
+	}
+	for _, name := range sensitive {
+		out.Del(name)
+	}
+	return out
+}
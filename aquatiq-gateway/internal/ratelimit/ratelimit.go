@@ -182,7 +182,7 @@ func (pl *PerIPLimiter) Middleware(audit *audit.AuditLogger) func(http.Handler)
 					audit.LogRateLimitExceeded(r, "per-ip")
 				}
 
-				w.Header().Set("X-RateLimit-Limit", fmt.Sprintf("%d", pl.limit))
+				w.Header().Set("X-RateLimit-Limit", fmt.Sprintf("%d", int(pl.limit)))
 				w.Header().Set("X-RateLimit-Remaining", "0")
 				w.Header().Set("Retry-After", "60")
 				w.WriteHeader(http.StatusTooManyRequests)
@@ -0,0 +1,93 @@
+package schema
+
+import (
+	"reflect"
+	"time"
+)
+
+// Canonical models are the provider-neutral shapes downstream services use.
+// Each record names the schema it was mapped from in Source.
+
+// Address is a postal address
+type Address struct {
+	Line1      string `json:"line1,omitempty"`
+	Line2      string `json:"line2,omitempty"`
+	PostalCode string `json:"postal_code,omitempty"`
+	City       string `json:"city,omitempty"`
+	Country    string `json:"country,omitempty"` // ISO 3166-1 alpha-2 where the provider has it
+}
+
+// Customer is a company we sell to
+type Customer struct {
+	Source    string    `json:"source"`
+	ID        string    `json:"id"`
+	Number    string    `json:"number,omitempty"`
+	Name      string    `json:"name"`
+	OrgNumber string    `json:"org_number,omitempty"`
+	VATNumber string    `json:"vat_number,omitempty"`
+	Email     string    `json:"email,omitempty"`
+	Phone     string    `json:"phone,omitempty"`
+	Website   string    `json:"website,omitempty"`
+	Currency  string    `json:"currency,omitempty"`
+	Status    string    `json:"status,omitempty"`
+	Address   Address   `json:"address"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Contact is a person at a customer
+type Contact struct {
+	Source     string    `json:"source"`
+	ID         string    `json:"id"`
+	CustomerID string    `json:"customer_id,omitempty"`
+	Name       string    `json:"name"`
+	FirstName  string    `json:"first_name,omitempty"`
+	LastName   string    `json:"last_name,omitempty"`
+	Title      string    `json:"title,omitempty"`
+	Email      string    `json:"email,omitempty"`
+	Phone      string    `json:"phone,omitempty"`
+	Mobile     string    `json:"mobile,omitempty"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// InvoiceLine is a line of an invoice
+type InvoiceLine struct {
+	Number      string  `json:"number,omitempty"`
+	ItemNumber  string  `json:"item_number,omitempty"`
+	Description string  `json:"description,omitempty"`
+	Quantity    float64 `json:"quantity"`
+	UnitPrice   float64 `json:"unit_price"`
+	Amount      float64 `json:"amount"`
+}
+
+// Invoice is a customer invoice
+type Invoice struct {
+	Source       string        `json:"source"`
+	ID           string        `json:"id"`
+	Number       string        `json:"number,omitempty"`
+	Type         string        `json:"type,omitempty"`
+	Status       string        `json:"status,omitempty"`
+	CustomerID   string        `json:"customer_id,omitempty"`
+	CustomerName string        `json:"customer_name,omitempty"`
+	Currency     string        `json:"currency,omitempty"`
+	Amount       float64       `json:"amount"`
+	Balance      float64       `json:"balance"`
+	VATTotal     float64       `json:"vat_total"`
+	IssueDate    time.Time     `json:"issue_date"`
+	DueDate      time.Time     `json:"due_date"`
+	Lines        []InvoiceLine `json:"lines,omitempty"`
+	UpdatedAt    time.Time     `json:"updated_at"`
+}
+
+// Canonical model names used as mapping targets
+const (
+	TargetCustomer = "customer"
+	TargetContact  = "contact"
+	TargetInvoice  = "invoice"
+)
+
+// canonicalTypes are the models mappings can produce
+var canonicalTypes = map[string]reflect.Type{
+	TargetCustomer: reflect.TypeOf(Customer{}),
+	TargetContact:  reflect.TypeOf(Contact{}),
+	TargetInvoice:  reflect.TypeOf(Invoice{}),
+}
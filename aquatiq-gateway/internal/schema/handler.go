@@ -0,0 +1,131 @@
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/aquatiq/integration-gateway/internal/audit"
+	"github.com/go-chi/chi/v5"
+)
+
+// Handler serves the schema registry REST API
+type Handler struct {
+	registry *Registry
+	audit    *audit.AuditLogger
+}
+
+// NewHandler creates the schema REST handler
+func NewHandler(registry *Registry, auditLogger *audit.AuditLogger) *Handler {
+	return &Handler{registry: registry, audit: auditLogger}
+}
+
+// List returns the registered schemas, endpoint bindings and mappings
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	h.respondJSON(w, http.StatusOK, map[string]interface{}{
+		"schemas":   h.registry.Schemas(),
+		"endpoints": h.registry.Endpoints(),
+		"mappings":  h.registry.Mappings(),
+	})
+}
+
+// Stats returns validation and mapping counters per schema version
+func (h *Handler) Stats(w http.ResponseWriter, r *http.Request) {
+	h.respondJSON(w, http.StatusOK, h.registry.Stats())
+}
+
+// Get returns a schema document; ?version= selects a version other than the active one
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "provider") + "/" + chi.URLParam(r, "name")
+	version := 0
+	if v := r.URL.Query().Get("version"); v != "" {
+		var err error
+		if version, err = strconv.Atoi(v); err != nil || version < 1 {
+			h.respondError(w, http.StatusBadRequest, "invalid version")
+			return
+		}
+	}
+
+	raw, version, ok := h.registry.Schema(name, version)
+	if !ok {
+		h.respondError(w, http.StatusNotFound, "schema not found")
+		return
+	}
+	w.Header().Set("Content-Type", "application/schema+json")
+	w.Header().Set("X-Schema-Version", strconv.Itoa(version))
+	w.WriteHeader(http.StatusOK)
+	w.Write(raw)
+}
+
+// Map converts a provider payload, or a list of them, to a canonical model:
+// POST /schemas/map?source=visma/customer&target=customer
+func (h *Handler) Map(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	source, target := r.URL.Query().Get("source"), r.URL.Query().Get("target")
+	if source == "" || target == "" {
+		h.respondError(w, http.StatusBadRequest, "source and target are required")
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, 10<<20))
+	if err != nil {
+		h.respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	// A list maps element by element
+	var items []json.RawMessage
+	isList := len(bytes.TrimSpace(body)) > 0 && bytes.TrimSpace(body)[0] == '['
+	if isList {
+		if err := json.Unmarshal(body, &items); err != nil {
+			h.respondError(w, http.StatusBadRequest, "invalid JSON list")
+			return
+		}
+	} else {
+		items = []json.RawMessage{body}
+	}
+
+	results := make([]interface{}, len(items))
+	for i, item := range items {
+		if results[i], err = h.registry.Map(r.Context(), source, target, item); err != nil {
+			break
+		}
+	}
+	if h.audit != nil {
+		h.audit.LogHTTPRequest(r, "schema_map", err == nil, err, time.Since(start))
+	}
+	if err != nil {
+		status := http.StatusUnprocessableEntity
+		if errors.Is(err, ErrNoMapping) {
+			status = http.StatusNotFound
+		}
+		h.respondError(w, status, err.Error())
+		return
+	}
+
+	if isList {
+		h.respondJSON(w, http.StatusOK, results)
+		return
+	}
+	h.respondJSON(w, http.StatusOK, results[0])
+}
+
+// Helper functions
+
+// respondError writes a JSON error response
+func (h *Handler) respondError(w http.ResponseWriter, status int, message string) {
+	h.respondJSON(w, status, map[string]string{
+		"error": message,
+	})
+}
+
+// respondJSON writes a JSON response
+func (h *Handler) respondJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
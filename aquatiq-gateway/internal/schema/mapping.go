@@ -0,0 +1,463 @@
+package schema
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aquatiq/integration-gateway/internal/audit"
+	"gopkg.in/yaml.v3"
+)
+
+// ErrNoMapping is returned when no mapping exists from a schema to a model
+var ErrNoMapping = errors.New("no mapping for source and target")
+
+// timeLayouts are the date formats providers use; dates without a zone are UTC
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02",
+}
+
+// transforms can be applied to mapped strings with "| name"
+var transforms = map[string]func(string) string{
+	"lower": strings.ToLower,
+	"upper": strings.ToUpper,
+	"trim":  strings.TrimSpace,
+}
+
+// MappingInfo describes a mapping
+type MappingInfo struct {
+	Source string `json:"source"`
+	Target string `json:"target"`
+	Fields int    `json:"fields"`
+}
+
+// mapping converts one provider schema to one canonical model
+type mapping struct {
+	source string
+	target string
+	typ    reflect.Type
+	fields []fieldMapping
+}
+
+// fieldMapping fills one canonical field
+type fieldMapping struct {
+	target string // Canonical field, e.g. address.city
+	index  []int  // Struct field index path of the target
+	expr   []alternative
+	list   *listMapping // Set for list fields
+}
+
+// listMapping fills a list field from a list in the payload
+type listMapping struct {
+	from   []pathSegment
+	fields []fieldMapping
+}
+
+// alternative is one "path | transform" term of an expression
+type alternative struct {
+	path       []pathSegment
+	transforms []func(string) string
+}
+
+// pathSegment is one step into a payload: an object key, optionally followed by [index]
+type pathSegment struct {
+	key   string
+	index int // -1 when the segment has no index
+}
+
+// fieldSpec is a field in mappings.yaml: an expression or a list mapping
+type fieldSpec struct {
+	expr   string
+	from   string
+	fields map[string]fieldSpec
+}
+
+// UnmarshalYAML reads a scalar expression or a {from, fields} list mapping
+func (f *fieldSpec) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		f.expr = node.Value
+		return nil
+	}
+
+	var list struct {
+		From   string               `yaml:"from"`
+		Fields map[string]fieldSpec `yaml:"fields"`
+	}
+	if err := node.Decode(&list); err != nil {
+		return err
+	}
+	f.from, f.fields = list.From, list.Fields
+	return nil
+}
+
+// Map converts a provider payload to a canonical model and returns a
+// pointer to it, e.g. *Customer for target "customer". The payload is first
+// validated against the source schema; in report mode a mismatch is only
+// reported, since most fields usually still map.
+func (r *Registry) Map(ctx context.Context, source, target string, payload []byte) (interface{}, error) {
+	m, ok := r.mappings[source+" "+target]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s to %s", ErrNoMapping, source, target)
+	}
+
+	if verr := r.check(source, "mapping to "+target, payload, ""); verr != nil {
+		r.report(verr, map[string]string{"target": target}, payload, "")
+		if r.mode == ModeEnforce {
+			r.mappingFailures.Add(1)
+			return nil, verr
+		}
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	var doc interface{}
+	if err := decoder.Decode(&doc); err != nil {
+		r.mappingFailed(source, target, err)
+		return nil, fmt.Errorf("invalid %s payload: %w", source, err)
+	}
+
+	out := reflect.New(m.typ)
+	if err := applyFields(out.Elem(), m.fields, doc); err != nil {
+		r.mappingFailed(source, target, err)
+		return nil, fmt.Errorf("failed to map %s to %s: %w", source, target, err)
+	}
+	out.Elem().FieldByName("Source").SetString(source)
+	if contact, ok := out.Interface().(*Contact); ok && contact.Name == "" {
+		contact.Name = strings.TrimSpace(contact.FirstName + " " + contact.LastName)
+	}
+
+	r.mapped.Add(1)
+	return out.Interface(), nil
+}
+
+// MapCustomer converts a provider payload to a canonical customer
+func (r *Registry) MapCustomer(ctx context.Context, source string, payload []byte) (*Customer, error) {
+	out, err := r.Map(ctx, source, TargetCustomer, payload)
+	if err != nil {
+		return nil, err
+	}
+	return out.(*Customer), nil
+}
+
+// MapContact converts a provider payload to a canonical contact
+func (r *Registry) MapContact(ctx context.Context, source string, payload []byte) (*Contact, error) {
+	out, err := r.Map(ctx, source, TargetContact, payload)
+	if err != nil {
+		return nil, err
+	}
+	return out.(*Contact), nil
+}
+
+// MapInvoice converts a provider payload to a canonical invoice
+func (r *Registry) MapInvoice(ctx context.Context, source string, payload []byte) (*Invoice, error) {
+	out, err := r.Map(ctx, source, TargetInvoice, payload)
+	if err != nil {
+		return nil, err
+	}
+	return out.(*Invoice), nil
+}
+
+// Mappings returns the available mappings
+func (r *Registry) Mappings() []MappingInfo {
+	infos := make([]MappingInfo, 0, len(r.mappings))
+	for _, m := range r.mappings {
+		infos = append(infos, MappingInfo{Source: m.source, Target: m.target, Fields: len(m.fields)})
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Source+" "+infos[i].Target < infos[j].Source+" "+infos[j].Target
+	})
+	return infos
+}
+
+// mappingFailed counts and audits a payload that could not be mapped
+func (r *Registry) mappingFailed(source, target string, err error) {
+	r.mappingFailures.Add(1)
+	if r.audit == nil {
+		return
+	}
+	r.audit.LogEvent(audit.AuditEvent{
+		Timestamp: time.Now(),
+		Action:    "schema_mapping_failed",
+		Actor:     "gateway",
+		Resource:  source,
+		Success:   false,
+		Error:     truncate(err.Error(), 1000),
+		Details:   map[string]string{"target": target},
+	})
+}
+
+// loadMappings reads mappings.yaml and resolves every field against the canonical models
+func (r *Registry) loadMappings(sources []fs.FS) error {
+	data, err := lastFile(sources, "mappings.yaml")
+	if err != nil {
+		return err
+	}
+
+	var file struct {
+		Mappings []struct {
+			Source string               `yaml:"source"`
+			Target string               `yaml:"target"`
+			Fields map[string]fieldSpec `yaml:"fields"`
+		} `yaml:"mappings"`
+	}
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("invalid mappings.yaml: %w", err)
+	}
+
+	for _, spec := range file.Mappings {
+		typ, ok := canonicalTypes[spec.Target]
+		if !ok {
+			return fmt.Errorf("mappings.yaml: unknown target %q", spec.Target)
+		}
+		if _, ok := r.schemas[spec.Source]; !ok {
+			return fmt.Errorf("mappings.yaml: unknown source schema %s", spec.Source)
+		}
+
+		fields, err := compileFields(typ, spec.Fields)
+		if err != nil {
+			return fmt.Errorf("mappings.yaml: %s to %s: %w", spec.Source, spec.Target, err)
+		}
+		r.mappings[spec.Source+" "+spec.Target] = &mapping{source: spec.Source, target: spec.Target, typ: typ, fields: fields}
+	}
+	return nil
+}
+
+// Helper functions
+
+// compileFields resolves field specs against a struct type
+func compileFields(typ reflect.Type, specs map[string]fieldSpec) ([]fieldMapping, error) {
+	fields := make([]fieldMapping, 0, len(specs))
+	for target, spec := range specs {
+		index, fieldType, err := fieldIndex(typ, target)
+		if err != nil {
+			return nil, err
+		}
+		f := fieldMapping{target: target, index: index}
+
+		if spec.fields != nil {
+			if fieldType.Kind() != reflect.Slice || fieldType.Elem().Kind() != reflect.Struct {
+				return nil, fmt.Errorf("%s is not a list of records", target)
+			}
+			from, err := parsePath(spec.from)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", target, err)
+			}
+			elementFields, err := compileFields(fieldType.Elem(), spec.fields)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", target, err)
+			}
+			f.list = &listMapping{from: from, fields: elementFields}
+		} else {
+			if f.expr, err = parseExpr(spec.expr); err != nil {
+				return nil, fmt.Errorf("%s: %w", target, err)
+			}
+		}
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
+
+// fieldIndex finds a dotted field by its JSON names
+func fieldIndex(typ reflect.Type, target string) ([]int, reflect.Type, error) {
+	var index []int
+	for _, name := range strings.Split(target, ".") {
+		if typ.Kind() != reflect.Struct {
+			return nil, nil, fmt.Errorf("unknown field %s", target)
+		}
+		found := false
+		for i := 0; i < typ.NumField(); i++ {
+			tag, _, _ := strings.Cut(typ.Field(i).Tag.Get("json"), ",")
+			if tag == name {
+				index = append(index, i)
+				typ = typ.Field(i).Type
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, nil, fmt.Errorf("unknown field %s", target)
+		}
+	}
+	return index, typ, nil
+}
+
+// parseExpr parses "a.b[0] | lower || c"
+func parseExpr(expr string) ([]alternative, error) {
+	var alternatives []alternative
+	for _, term := range strings.Split(expr, "||") {
+		parts := strings.Split(term, "|")
+		path, err := parsePath(parts[0])
+		if err != nil {
+			return nil, err
+		}
+		alt := alternative{path: path}
+		for _, name := range parts[1:] {
+			fn, ok := transforms[strings.TrimSpace(name)]
+			if !ok {
+				return nil, fmt.Errorf("unknown transform %q", strings.TrimSpace(name))
+			}
+			alt.transforms = append(alt.transforms, fn)
+		}
+		alternatives = append(alternatives, alt)
+	}
+	return alternatives, nil
+}
+
+// parsePath parses "a.b[0].c"
+func parsePath(s string) ([]pathSegment, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, fmt.Errorf("empty path")
+	}
+
+	var path []pathSegment
+	for _, part := range strings.Split(s, ".") {
+		segment := pathSegment{key: part, index: -1}
+		if key, rest, ok := strings.Cut(part, "["); ok {
+			n, err := strconv.Atoi(strings.TrimSuffix(rest, "]"))
+			if err != nil || !strings.HasSuffix(rest, "]") || n < 0 {
+				return nil, fmt.Errorf("invalid path %q", s)
+			}
+			segment = pathSegment{key: key, index: n}
+		}
+		if segment.key == "" {
+			return nil, fmt.Errorf("invalid path %q", s)
+		}
+		path = append(path, segment)
+	}
+	return path, nil
+}
+
+// applyFields fills a struct from a payload
+func applyFields(out reflect.Value, fields []fieldMapping, doc interface{}) error {
+	for _, f := range fields {
+		field := out.FieldByIndex(f.index)
+
+		if f.list != nil {
+			elements, _ := lookup(doc, f.list.from).([]interface{})
+			list := reflect.MakeSlice(field.Type(), len(elements), len(elements))
+			for i, element := range elements {
+				if err := applyFields(list.Index(i), f.list.fields, element); err != nil {
+					return fmt.Errorf("%s[%d]: %w", f.target, i, err)
+				}
+			}
+			field.Set(list)
+			continue
+		}
+
+		value := evaluate(f.expr, doc)
+		if value == nil {
+			continue
+		}
+		if err := setValue(field, value); err != nil {
+			return fmt.Errorf("%s: %w", f.target, err)
+		}
+	}
+	return nil
+}
+
+// evaluate returns the first non-empty alternative of an expression
+func evaluate(expr []alternative, doc interface{}) interface{} {
+	for _, alt := range expr {
+		value := lookup(doc, alt.path)
+		if s, ok := value.(string); ok {
+			for _, fn := range alt.transforms {
+				s = fn(s)
+			}
+			value = s
+		}
+		if value != nil && value != "" {
+			return value
+		}
+	}
+	return nil
+}
+
+// lookup follows a path into a decoded payload; keys match case-insensitively
+// when there is no exact match
+func lookup(doc interface{}, path []pathSegment) interface{} {
+	for _, segment := range path {
+		object, ok := doc.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value, ok := object[segment.key]
+		if !ok {
+			for key, v := range object {
+				if strings.EqualFold(key, segment.key) {
+					value = v
+					break
+				}
+			}
+		}
+		if segment.index >= 0 {
+			list, ok := value.([]interface{})
+			if !ok || segment.index >= len(list) {
+				return nil
+			}
+			value = list[segment.index]
+		}
+		doc = value
+	}
+	return doc
+}
+
+// setValue stores a payload value in a canonical field, converting between
+// strings, numbers and dates
+func setValue(field reflect.Value, value interface{}) error {
+	if field.Type() == reflect.TypeOf(time.Time{}) {
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("expected a date, got %T", value)
+		}
+		for _, layout := range timeLayouts {
+			if t, err := time.ParseInLocation(layout, s, time.UTC); err == nil {
+				field.Set(reflect.ValueOf(t))
+				return nil
+			}
+		}
+		return fmt.Errorf("invalid date %q", s)
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		switch v := value.(type) {
+		case string:
+			field.SetString(v)
+		case json.Number:
+			field.SetString(v.String())
+		case bool:
+			field.SetString(strconv.FormatBool(v))
+		default:
+			return fmt.Errorf("expected a string, got %T", value)
+		}
+	case reflect.Float64:
+		var n float64
+		var err error
+		switch v := value.(type) {
+		case json.Number:
+			n, err = v.Float64()
+		case string:
+			n, err = strconv.ParseFloat(v, 64)
+		default:
+			err = fmt.Errorf("expected a number, got %T", value)
+		}
+		if err != nil {
+			return err
+		}
+		field.SetFloat(n)
+	default:
+		return fmt.Errorf("unsupported field type %s", field.Type())
+	}
+	return nil
+}
@@ -0,0 +1,509 @@
+package schema
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/aquatiq/integration-gateway/internal/audit"
+	"github.com/aquatiq/integration-gateway/pkg/httpclient"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"gopkg.in/yaml.v3"
+)
+
+// Validation modes
+const (
+	ModeReport  = "report"  // Count and audit failures, let the call through
+	ModeEnforce = "enforce" // Also reject the call
+)
+
+// ErrInvalidPayload is wrapped by errors for payloads that break their schema
+var ErrInvalidPayload = errors.New("payload does not match its schema")
+
+//go:embed schemas
+var builtin embed.FS
+
+// schemaFile matches {provider}/{name}.v{version}.json
+var schemaFile = regexp.MustCompile(`^([a-z0-9_-]+/[a-z0-9_-]+)\.v([0-9]+)\.json$`)
+
+// schemaBaseURL makes schema files addressable for $ref resolution
+const schemaBaseURL = "https://schemas.aquatiq.local/"
+
+// Config holds schema registry configuration
+type Config struct {
+	Mode        string         // report or enforce (default report)
+	Dir         string         // Optional directory overriding the built-in schemas, endpoints.yaml and mappings.yaml
+	Versions    map[string]int // Version to validate each schema against; latest when unset
+	AuditLogger *audit.AuditLogger
+}
+
+// Registry holds versioned JSON Schemas for integration payloads, the
+// endpoints they apply to and the mappings to canonical models. It
+// implements httpclient.PayloadValidator.
+type Registry struct {
+	mode      string
+	schemas   map[string]map[int]*entry // name → version → schema
+	active    map[string]int            // name → version validated against
+	endpoints []Endpoint
+	mappings  map[string]*mapping // source + " " + target
+	audit     *audit.AuditLogger
+
+	mu    sync.Mutex
+	stats map[string]*SchemaStats // name@version → stats
+
+	mapped          atomic.Uint64
+	mappingFailures atomic.Uint64
+}
+
+// entry is one compiled schema version
+type entry struct {
+	schema *jsonschema.Schema
+	raw    []byte
+}
+
+// Endpoint binds an integration endpoint to the schemas of its payloads
+type Endpoint struct {
+	Service  string `yaml:"service" json:"service"`
+	Method   string `yaml:"method" json:"method"`
+	Path     string `yaml:"path" json:"path"` // Matched against the end of the URL path
+	Request  string `yaml:"request" json:"request,omitempty"`
+	Response string `yaml:"response" json:"response,omitempty"`
+	Items    string `yaml:"items" json:"items,omitempty"` // Response field holding list items; "." for a top-level array
+}
+
+// SchemaInfo describes a registered schema
+type SchemaInfo struct {
+	Name     string `json:"name"`
+	Versions []int  `json:"versions"`
+	Active   int    `json:"active"`
+}
+
+// SchemaStats counts validations of one schema version
+type SchemaStats struct {
+	Validated     uint64     `json:"validated"`
+	Failed        uint64     `json:"failed"`
+	LastError     string     `json:"last_error,omitempty"`
+	LastFailureAt *time.Time `json:"last_failure_at,omitempty"`
+}
+
+// Stats holds registry statistics
+type Stats struct {
+	Mode            string                 `json:"mode"`
+	Schemas         int                    `json:"schemas"`
+	Endpoints       int                    `json:"endpoints"`
+	Validated       uint64                 `json:"validated"`
+	Failed          uint64                 `json:"failed"`
+	BySchema        map[string]SchemaStats `json:"by_schema"`
+	Mapped          uint64                 `json:"mapped"`
+	MappingFailures uint64                 `json:"mapping_failures"`
+}
+
+// ValidationError reports a payload that does not match its schema
+type ValidationError struct {
+	Schema  string
+	Version int
+	Where   string // e.g. "response of GET /customer" or "mapping to customer"
+	Reason  string
+}
+
+// Error implements error
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s does not match %s@%d: %s", e.Where, e.Schema, e.Version, e.Reason)
+}
+
+// Unwrap makes errors.Is(err, ErrInvalidPayload) match
+func (e *ValidationError) Unwrap() error {
+	return ErrInvalidPayload
+}
+
+// New loads and compiles the built-in schemas, overridden by those in cfg.Dir
+func New(cfg Config) (*Registry, error) {
+	if cfg.Mode == "" {
+		cfg.Mode = ModeReport
+	}
+	if cfg.Mode != ModeReport && cfg.Mode != ModeEnforce {
+		return nil, fmt.Errorf("invalid schema mode: %s", cfg.Mode)
+	}
+
+	sources := []fs.FS{}
+	embedded, err := fs.Sub(builtin, "schemas")
+	if err != nil {
+		return nil, err
+	}
+	sources = append(sources, embedded)
+	if cfg.Dir != "" {
+		if _, err := os.Stat(cfg.Dir); err != nil {
+			return nil, fmt.Errorf("schema directory: %w", err)
+		}
+		sources = append(sources, os.DirFS(cfg.Dir))
+	}
+
+	r := &Registry{
+		mode:     cfg.Mode,
+		schemas:  make(map[string]map[int]*entry),
+		active:   make(map[string]int),
+		mappings: make(map[string]*mapping),
+		audit:    cfg.AuditLogger,
+		stats:    make(map[string]*SchemaStats),
+	}
+	if err := r.loadSchemas(sources); err != nil {
+		return nil, err
+	}
+
+	for name, version := range cfg.Versions {
+		if _, ok := r.schemas[name][version]; !ok {
+			return nil, fmt.Errorf("schema %s has no version %d", name, version)
+		}
+		r.active[name] = version
+	}
+
+	// The last source that has them replaces the endpoint and mapping files
+	if err := r.loadEndpoints(sources); err != nil {
+		return nil, err
+	}
+	if err := r.loadMappings(sources); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Validate checks an integration payload against the schema bound to its
+// endpoint. Failures are counted and audited; in enforce mode they are
+// also returned, which rejects the call.
+func (r *Registry) Validate(ctx context.Context, p httpclient.Payload) error {
+	endpoint, ok := r.endpoint(p.Service, p.Method, p.Path)
+	if !ok {
+		return nil
+	}
+	name, items := endpoint.Response, endpoint.Items
+	if p.Direction == httpclient.DirectionRequest {
+		name, items = endpoint.Request, ""
+	}
+	if name == "" {
+		return nil
+	}
+
+	where := fmt.Sprintf("%s of %s %s", p.Direction, p.Method, p.Path)
+	err := r.check(name, where, p.Body, items)
+	if err == nil {
+		return nil
+	}
+	r.report(err, map[string]string{
+		"service":   p.Service,
+		"method":    p.Method,
+		"path":      p.Path,
+		"direction": p.Direction,
+	}, p.Body, items)
+	if r.mode == ModeEnforce {
+		return err
+	}
+	return nil
+}
+
+// Schemas returns the registered schemas with their versions
+func (r *Registry) Schemas() []SchemaInfo {
+	infos := make([]SchemaInfo, 0, len(r.schemas))
+	for name, versions := range r.schemas {
+		info := SchemaInfo{Name: name, Active: r.active[name]}
+		for version := range versions {
+			info.Versions = append(info.Versions, version)
+		}
+		sort.Ints(info.Versions)
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+	return infos
+}
+
+// Schema returns the JSON document of a schema version; 0 selects the active one
+func (r *Registry) Schema(name string, version int) ([]byte, int, bool) {
+	if version == 0 {
+		version = r.active[name]
+	}
+	e, ok := r.schemas[name][version]
+	if !ok {
+		return nil, 0, false
+	}
+	return e.raw, version, true
+}
+
+// Endpoints returns the endpoint bindings
+func (r *Registry) Endpoints() []Endpoint {
+	return append([]Endpoint(nil), r.endpoints...)
+}
+
+// Stats returns validation and mapping statistics
+func (r *Registry) Stats() Stats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stats := Stats{
+		Mode:            r.mode,
+		Schemas:         len(r.schemas),
+		Endpoints:       len(r.endpoints),
+		BySchema:        make(map[string]SchemaStats, len(r.stats)),
+		Mapped:          r.mapped.Load(),
+		MappingFailures: r.mappingFailures.Load(),
+	}
+	for key, s := range r.stats {
+		stats.BySchema[key] = *s
+		stats.Validated += s.Validated
+		stats.Failed += s.Failed
+	}
+	return stats
+}
+
+// check validates a body against the active version of a schema. With items
+// set, the body is a list whose elements are validated one by one.
+func (r *Registry) check(name, where string, body []byte, items string) *ValidationError {
+	version := r.active[name]
+	e, ok := r.schemas[name][version]
+	if !ok {
+		return nil
+	}
+
+	reason := validateBody(e.schema, body, items)
+	r.count(name, version, reason)
+	if reason == "" {
+		return nil
+	}
+	return &ValidationError{Schema: name, Version: version, Where: where, Reason: reason}
+}
+
+// report audits a validation failure, naming another version of the schema
+// the payload does match, which usually means the provider changed its format
+func (r *Registry) report(verr *ValidationError, details map[string]string, body []byte, items string) {
+	if r.audit == nil {
+		return
+	}
+
+	for version, e := range r.schemas[verr.Schema] {
+		if version != verr.Version && validateBody(e.schema, body, items) == "" {
+			details["matches_version"] = strconv.Itoa(version)
+			break
+		}
+	}
+	r.audit.LogEvent(audit.AuditEvent{
+		Timestamp: time.Now(),
+		Action:    "schema_validation_failed",
+		Actor:     "gateway",
+		Resource:  fmt.Sprintf("%s@%d", verr.Schema, verr.Version),
+		Success:   false,
+		Error:     truncate(verr.Error(), 1000),
+		Details:   details,
+	})
+}
+
+// count records the outcome of a validation
+func (r *Registry) count(name string, version int, reason string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := fmt.Sprintf("%s@%d", name, version)
+	s, ok := r.stats[key]
+	if !ok {
+		s = &SchemaStats{}
+		r.stats[key] = s
+	}
+	s.Validated++
+	if reason != "" {
+		s.Failed++
+		s.LastError = truncate(reason, 500)
+		now := time.Now()
+		s.LastFailureAt = &now
+	}
+}
+
+// endpoint returns the first binding matching a call
+func (r *Registry) endpoint(service, method, urlPath string) (Endpoint, bool) {
+	segments := strings.Split(strings.Trim(urlPath, "/"), "/")
+	for _, e := range r.endpoints {
+		if e.Service != service || !strings.EqualFold(e.Method, method) {
+			continue
+		}
+		pattern := strings.Split(strings.Trim(e.Path, "/"), "/")
+		if len(pattern) > len(segments) {
+			continue
+		}
+		if ok, _ := path.Match(strings.Join(pattern, "/"), strings.Join(segments[len(segments)-len(pattern):], "/")); ok {
+			return e, true
+		}
+	}
+	return Endpoint{}, false
+}
+
+// loadSchemas compiles every schema file; later sources override earlier ones
+func (r *Registry) loadSchemas(sources []fs.FS) error {
+	files := make(map[string][]byte)
+	for _, source := range sources {
+		err := fs.WalkDir(source, ".", func(name string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() || !schemaFile.MatchString(name) {
+				return err
+			}
+			data, err := fs.ReadFile(source, name)
+			if err != nil {
+				return err
+			}
+			files[name] = data
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to read schemas: %w", err)
+		}
+	}
+
+	compiler := jsonschema.NewCompiler()
+	compiler.DefaultDraft(jsonschema.Draft2020)
+	for name, data := range files {
+		doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(data))
+		if err != nil {
+			return fmt.Errorf("invalid schema %s: %w", name, err)
+		}
+		if err := compiler.AddResource(schemaBaseURL+name, doc); err != nil {
+			return fmt.Errorf("invalid schema %s: %w", name, err)
+		}
+	}
+
+	for file, data := range files {
+		compiled, err := compiler.Compile(schemaBaseURL + file)
+		if err != nil {
+			return fmt.Errorf("failed to compile schema %s: %w", file, err)
+		}
+
+		match := schemaFile.FindStringSubmatch(file)
+		name := match[1]
+		version, _ := strconv.Atoi(match[2])
+		if r.schemas[name] == nil {
+			r.schemas[name] = make(map[int]*entry)
+		}
+		r.schemas[name][version] = &entry{schema: compiled, raw: data}
+		if version > r.active[name] {
+			r.active[name] = version
+		}
+	}
+	return nil
+}
+
+// loadEndpoints reads the endpoint bindings and checks the schemas they name
+func (r *Registry) loadEndpoints(sources []fs.FS) error {
+	data, err := lastFile(sources, "endpoints.yaml")
+	if err != nil {
+		return err
+	}
+
+	var file struct {
+		Endpoints []Endpoint `yaml:"endpoints"`
+	}
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("invalid endpoints.yaml: %w", err)
+	}
+	for _, e := range file.Endpoints {
+		if e.Service == "" || e.Method == "" || e.Path == "" {
+			return fmt.Errorf("endpoints.yaml: service, method and path are required")
+		}
+		if _, err := path.Match(e.Path, ""); err != nil {
+			return fmt.Errorf("endpoints.yaml: invalid path %q", e.Path)
+		}
+		for _, name := range []string{e.Request, e.Response} {
+			if _, ok := r.schemas[name]; name != "" && !ok {
+				return fmt.Errorf("endpoints.yaml: unknown schema %s", name)
+			}
+		}
+	}
+	r.endpoints = file.Endpoints
+	return nil
+}
+
+// Helper functions
+
+// validateBody returns why a body does not match a schema, or "" when it does
+func validateBody(schema *jsonschema.Schema, body []byte, items string) string {
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(body))
+	if err != nil {
+		return "invalid JSON: " + err.Error()
+	}
+
+	if items == "" {
+		return reason(schema.Validate(doc))
+	}
+
+	list := doc
+	if items != "." {
+		object, ok := doc.(map[string]any)
+		if !ok {
+			return "expected an object with a " + items + " list"
+		}
+		list = object[items]
+	}
+	elements, ok := list.([]any)
+	if !ok {
+		if list == nil && items != "." {
+			return "missing " + items + " list"
+		}
+		return "expected a list"
+	}
+	for i, element := range elements {
+		if r := reason(schema.Validate(element)); r != "" {
+			return fmt.Sprintf("item %d: %s", i, r)
+		}
+	}
+	return ""
+}
+
+// reason flattens a validation error to "at '/path': problem; ..."
+func reason(err error) string {
+	if err == nil {
+		return ""
+	}
+	var verr *jsonschema.ValidationError
+	if !errors.As(err, &verr) {
+		return err.Error()
+	}
+
+	// The first line only names the schema URL
+	lines := strings.Split(verr.Error(), "\n")
+	problems := make([]string, 0, len(lines))
+	for _, line := range lines[1:] {
+		if line = strings.TrimLeft(strings.TrimSpace(line), "- "); line != "" {
+			problems = append(problems, line)
+		}
+	}
+	if len(problems) == 0 {
+		return lines[0]
+	}
+	return strings.Join(problems, "; ")
+}
+
+// lastFile returns a file from the last source that has it
+func lastFile(sources []fs.FS, name string) ([]byte, error) {
+	for i := len(sources) - 1; i >= 0; i-- {
+		data, err := fs.ReadFile(sources[i], name)
+		if err == nil {
+			return data, nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("failed to read %s: %w", name, err)
+		}
+	}
+	return nil, fmt.Errorf("%s not found", name)
+}
+
+// truncate shortens s to at most n bytes
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n] + "..."
+}
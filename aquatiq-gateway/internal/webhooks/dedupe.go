@@ -0,0 +1,130 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aquatiq/integration-gateway/internal/cache"
+	"github.com/redis/go-redis/v9"
+)
+
+// Claim outcomes
+const (
+	claimNew        = iota // First delivery; the caller must publish it
+	claimDelivered         // Already published
+	claimInProgress        // Another request is publishing it right now
+)
+
+// pendingTTL bounds how long a claimed delivery blocks redeliveries if the
+// gateway dies before publishing it
+const pendingTTL = time.Minute
+
+// Claim states
+const (
+	statePending   = "pending"
+	stateDelivered = "delivered"
+)
+
+// deduper remembers delivered event IDs in Redis, or in memory without it
+type deduper struct {
+	cache  *cache.RedisCache
+	ttl    time.Duration
+	prefix string
+	local  map[string]localClaim
+	mu     sync.Mutex
+}
+
+// localClaim is an in-memory claim
+type localClaim struct {
+	state     string
+	expiresAt time.Time
+}
+
+// newDeduper creates a deduper
+func newDeduper(c *cache.RedisCache, ttl time.Duration) *deduper {
+	return &deduper{
+		cache:  c,
+		ttl:    ttl,
+		prefix: "webhook:event:",
+		local:  make(map[string]localClaim),
+	}
+}
+
+// claim marks an event as being published unless it was seen before
+func (d *deduper) claim(ctx context.Context, provider, id string) (int, error) {
+	key := d.prefix + provider + ":" + id
+
+	if d.cache != nil {
+		ok, err := d.cache.Client().SetNX(ctx, key, statePending, pendingTTL).Result()
+		if err != nil {
+			return 0, fmt.Errorf("failed to claim event: %w", err)
+		}
+		if ok {
+			return claimNew, nil
+		}
+
+		state, err := d.cache.Client().Get(ctx, key).Result()
+		if err == redis.Nil {
+			// Released between the two calls; let the provider retry
+			return claimInProgress, nil
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to read event state: %w", err)
+		}
+		if state == stateDelivered {
+			return claimDelivered, nil
+		}
+		return claimInProgress, nil
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := time.Now()
+	for k, c := range d.local {
+		if now.After(c.expiresAt) {
+			delete(d.local, k)
+		}
+	}
+	if existing, ok := d.local[key]; ok {
+		if existing.state == stateDelivered {
+			return claimDelivered, nil
+		}
+		return claimInProgress, nil
+	}
+	d.local[key] = localClaim{state: statePending, expiresAt: now.Add(pendingTTL)}
+	return claimNew, nil
+}
+
+// delivered remembers a published event for the dedupe TTL
+func (d *deduper) delivered(ctx context.Context, provider, id string) error {
+	key := d.prefix + provider + ":" + id
+
+	if d.cache != nil {
+		if err := d.cache.Client().Set(ctx, key, stateDelivered, d.ttl).Err(); err != nil {
+			return fmt.Errorf("failed to mark event delivered: %w", err)
+		}
+		return nil
+	}
+
+	d.mu.Lock()
+	d.local[key] = localClaim{state: stateDelivered, expiresAt: time.Now().Add(d.ttl)}
+	d.mu.Unlock()
+	return nil
+}
+
+// release forgets a claim whose event could not be published, so the provider's retry is accepted
+func (d *deduper) release(ctx context.Context, provider, id string) {
+	key := d.prefix + provider + ":" + id
+
+	if d.cache != nil {
+		_ = d.cache.Client().Del(ctx, key).Err()
+		return
+	}
+
+	d.mu.Lock()
+	delete(d.local, key)
+	d.mu.Unlock()
+}
@@ -0,0 +1,232 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aquatiq/integration-gateway/internal/config"
+)
+
+// Verification methods
+const (
+	VerifyHMAC   = "hmac"   // HMAC-SHA256 of the raw body, keyed with the secret
+	VerifySecret = "secret" // The shared secret itself, in a header
+)
+
+// defaultTolerance is how far a signed delivery's timestamp may be from now
+const defaultTolerance = 5 * time.Minute
+
+// knownProviders fills in what the config leaves out
+var knownProviders = map[string]config.WebhookProviderConfig{
+	// SuperOffice signs each delivery, including its timestamp, with the webhook's secret
+	"superoffice": {
+		Verification:      VerifyHMAC,
+		SignatureHeader:   "X-SuperOffice-Signature",
+		SignatureEncoding: "base64",
+		TimestampField:    "Timestamp",
+		EventIDField:      "EventId",
+		EventTypeField:    "Event",
+	},
+	// Visma.net deliveries carry the shared secret in a header; never in the
+	// URL, which request logs record
+	"visma": {
+		Verification:    VerifySecret,
+		SignatureHeader: "X-Webhook-Secret",
+		EventIDField:    "id",
+		EventTypeField:  "event",
+	},
+}
+
+// provider is a webhook sender with its verification settings
+type provider struct {
+	name string
+	cfg  config.WebhookProviderConfig
+}
+
+// newProvider creates a provider from config, filling in defaults for known providers
+func newProvider(name string, cfg config.WebhookProviderConfig) (provider, error) {
+	if known, ok := knownProviders[name]; ok {
+		if cfg.Verification == "" {
+			cfg.Verification = known.Verification
+		}
+		if cfg.SignatureHeader == "" {
+			cfg.SignatureHeader = known.SignatureHeader
+		}
+		if cfg.SignatureEncoding == "" {
+			cfg.SignatureEncoding = known.SignatureEncoding
+		}
+		if cfg.TimestampField == "" {
+			cfg.TimestampField = known.TimestampField
+		}
+		if cfg.EventIDField == "" {
+			cfg.EventIDField = known.EventIDField
+		}
+		if cfg.EventTypeField == "" {
+			cfg.EventTypeField = known.EventTypeField
+		}
+	}
+
+	switch cfg.Verification {
+	case VerifyHMAC:
+		if cfg.SignatureHeader == "" {
+			return provider{}, fmt.Errorf("webhook provider %s: hmac verification needs a signature header", name)
+		}
+		if cfg.SignatureEncoding == "" {
+			cfg.SignatureEncoding = "hex"
+		}
+		if cfg.SignatureEncoding != "hex" && cfg.SignatureEncoding != "base64" {
+			return provider{}, fmt.Errorf("webhook provider %s: signature encoding must be hex or base64", name)
+		}
+		if cfg.TimestampField == "" {
+			return provider{}, fmt.Errorf("webhook provider %s: hmac verification needs a timestamp field", name)
+		}
+		if cfg.Tolerance <= 0 {
+			cfg.Tolerance = defaultTolerance
+		}
+	case VerifySecret:
+		if cfg.SignatureHeader == "" {
+			return provider{}, fmt.Errorf("webhook provider %s: secret verification needs a header", name)
+		}
+	default:
+		return provider{}, fmt.Errorf("webhook provider %s: verification must be hmac or secret", name)
+	}
+	if cfg.Secret == "" {
+		return provider{}, fmt.Errorf("webhook provider %s: secret is required", name)
+	}
+
+	return provider{name: name, cfg: cfg}, nil
+}
+
+// verify checks that a delivery was sent by the provider
+func (p provider) verify(r *http.Request, body []byte) error {
+	switch p.cfg.Verification {
+	case VerifyHMAC:
+		signature := strings.TrimSpace(r.Header.Get(p.cfg.SignatureHeader))
+		signature = strings.TrimPrefix(signature, p.cfg.SignaturePrefix)
+		if signature == "" {
+			return errors.New("signature is missing")
+		}
+
+		var got []byte
+		var err error
+		if p.cfg.SignatureEncoding == "base64" {
+			got, err = base64.StdEncoding.DecodeString(signature)
+		} else {
+			got, err = hex.DecodeString(signature)
+		}
+		if err != nil {
+			return errors.New("signature is malformed")
+		}
+
+		mac := hmac.New(sha256.New, []byte(p.cfg.Secret))
+		mac.Write(body)
+		if !hmac.Equal(got, mac.Sum(nil)) {
+			return errors.New("signature does not match")
+		}
+		return p.checkTimestamp(body, time.Now())
+
+	default:
+		secret := r.Header.Get(p.cfg.SignatureHeader)
+		if secret == "" {
+			return errors.New("shared secret is missing")
+		}
+		if subtle.ConstantTimeCompare([]byte(secret), []byte(p.cfg.Secret)) != 1 {
+			return errors.New("shared secret does not match")
+		}
+		return nil
+	}
+}
+
+// checkTimestamp rejects signed deliveries sent too long ago, so a captured
+// delivery cannot be replayed once the dedupe store has forgotten it. The
+// timestamp is part of the signed body.
+func (p provider) checkTimestamp(body []byte, now time.Time) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return errors.New("timestamp is missing")
+	}
+	value := field(fields, p.cfg.TimestampField)
+	if value == "" {
+		return errors.New("timestamp is missing")
+	}
+
+	sent, err := parseTimestamp(value)
+	if err != nil {
+		return errors.New("timestamp is malformed")
+	}
+	if skew := now.Sub(sent); skew > p.cfg.Tolerance || skew < -p.cfg.Tolerance {
+		return errors.New("timestamp is outside the tolerance")
+	}
+	return nil
+}
+
+// parseTimestamp parses an RFC 3339 time or Unix seconds or milliseconds
+func parseTimestamp(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return t, nil
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if n > 1e12 {
+		return time.UnixMilli(n), nil
+	}
+	return time.Unix(n, 0), nil
+}
+
+// identify returns the event ID and type of a payload. Payloads without an ID
+// are identified by their hash, so identical redeliveries are still deduplicated.
+func (p provider) identify(body []byte) (id, eventType string) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err == nil {
+		id = field(fields, p.cfg.EventIDField)
+		eventType = field(fields, p.cfg.EventTypeField)
+	}
+
+	if id == "" {
+		sum := sha256.Sum256(body)
+		id = "sha256:" + hex.EncodeToString(sum[:])
+	}
+	return id, eventType
+}
+
+// field returns a top-level string or number field, matching its name case-insensitively
+func field(fields map[string]json.RawMessage, name string) string {
+	if name == "" {
+		return ""
+	}
+
+	raw, ok := fields[name]
+	if !ok {
+		for key, value := range fields {
+			if strings.EqualFold(key, name) {
+				raw, ok = value, true
+				break
+			}
+		}
+	}
+	if !ok {
+		return ""
+	}
+
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	var n json.Number
+	if err := json.Unmarshal(raw, &n); err == nil {
+		return n.String()
+	}
+	return ""
+}
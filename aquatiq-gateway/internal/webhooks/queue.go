@@ -0,0 +1,112 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aquatiq/integration-gateway/internal/cache"
+	"github.com/aquatiq/integration-gateway/internal/messaging"
+	"github.com/redis/go-redis/v9"
+)
+
+// Event is an accepted webhook delivery as it is handed to downstream consumers
+type Event struct {
+	ID         string          `json:"id"`
+	Provider   string          `json:"provider"`
+	Type       string          `json:"type,omitempty"`
+	ReceivedAt time.Time       `json:"received_at"`
+	Payload    json.RawMessage `json:"payload"`
+}
+
+// Queue durably stores accepted events for downstream consumers
+type Queue interface {
+	Publish(ctx context.Context, event Event) error
+}
+
+// JetStreamQueue publishes events to webhooks.{provider}.{type} in a JetStream stream
+type JetStreamQueue struct {
+	js *messaging.JetStream
+}
+
+// NewJetStreamQueue creates the stream if needed and returns a queue publishing to it
+func NewJetStreamQueue(ctx context.Context, js *messaging.JetStream, stream string, retention time.Duration) (*JetStreamQueue, error) {
+	if err := js.EnsureStream(ctx, stream, []string{"webhooks.>"}, retention); err != nil {
+		return nil, err
+	}
+	return &JetStreamQueue{js: js}, nil
+}
+
+// Publish implements Queue. The event ID doubles as the message ID, so
+// JetStream also drops duplicates published within its duplicate window.
+func (q *JetStreamQueue) Publish(ctx context.Context, event Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+	return q.js.Publish(ctx, Subject(event.Provider, event.Type), event.Provider+":"+event.ID, data, map[string]string{
+		"Webhook-Provider": event.Provider,
+		"Webhook-Event":    event.Type,
+	})
+}
+
+// RedisStreamQueue appends events to one Redis stream per provider, {prefix}:{provider}
+type RedisStreamQueue struct {
+	cache  *cache.RedisCache
+	prefix string
+	maxLen int64
+}
+
+// NewRedisStreamQueue creates a queue that keeps about maxLen events per provider
+func NewRedisStreamQueue(c *cache.RedisCache, prefix string, maxLen int64) *RedisStreamQueue {
+	return &RedisStreamQueue{cache: c, prefix: prefix, maxLen: maxLen}
+}
+
+// Publish implements Queue
+func (q *RedisStreamQueue) Publish(ctx context.Context, event Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	err = q.cache.Client().XAdd(ctx, &redis.XAddArgs{
+		Stream: q.prefix + ":" + event.Provider,
+		MaxLen: q.maxLen,
+		Approx: true,
+		Values: map[string]interface{}{
+			"id":    event.ID,
+			"type":  event.Type,
+			"event": data,
+		},
+	}).Err()
+	if err != nil {
+		return fmt.Errorf("failed to append event to stream: %w", err)
+	}
+	return nil
+}
+
+// Subject returns the NATS subject of a provider's event type, e.g.
+// webhooks.superoffice.contact.changed for "contact.changed"
+func Subject(provider, eventType string) string {
+	var tokens []string
+	for _, token := range strings.Split(strings.ToLower(eventType), ".") {
+		token = strings.Map(func(r rune) rune {
+			switch {
+			case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '-', r == '_':
+				return r
+			case r == ' ':
+				return '_'
+			}
+			return -1
+		}, token)
+		if token != "" {
+			tokens = append(tokens, token)
+		}
+	}
+	if len(tokens) == 0 {
+		tokens = []string{"unknown"}
+	}
+	return "webhooks." + provider + "." + strings.Join(tokens, ".")
+}
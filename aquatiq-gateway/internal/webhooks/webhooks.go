@@ -0,0 +1,214 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aquatiq/integration-gateway/internal/audit"
+	"github.com/aquatiq/integration-gateway/internal/cache"
+	"github.com/aquatiq/integration-gateway/internal/config"
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+// publishTimeout bounds how long a delivery waits for the queue, so providers
+// get an answer well within their own timeouts
+const publishTimeout = 5 * time.Second
+
+// Config holds webhook receiver configuration
+type Config struct {
+	Providers    map[string]config.WebhookProviderConfig
+	Queue        Queue             // Where accepted events are handed off
+	Cache        *cache.RedisCache // Optional; delivered event IDs are kept in memory without it
+	DedupTTL     time.Duration     // How long delivered event IDs are remembered
+	MaxBodyBytes int64
+	AuditLogger  *audit.AuditLogger
+}
+
+// Handler receives provider webhooks. Deliveries are verified, deduplicated by
+// event ID and queued before they are acknowledged; processing happens downstream.
+type Handler struct {
+	providers    map[string]provider
+	queue        Queue
+	dedupe       *deduper
+	maxBodyBytes int64
+	audit        *audit.AuditLogger
+
+	mu        sync.RWMutex
+	listeners []func(Event)
+}
+
+// New creates a new webhook handler for the enabled providers
+func New(cfg Config) (*Handler, error) {
+	if cfg.Queue == nil {
+		return nil, errors.New("webhooks: a queue is required")
+	}
+	if cfg.DedupTTL == 0 {
+		cfg.DedupTTL = 24 * time.Hour
+	}
+	if cfg.MaxBodyBytes == 0 {
+		cfg.MaxBodyBytes = 1 << 20
+	}
+
+	h := &Handler{
+		providers:    make(map[string]provider),
+		queue:        cfg.Queue,
+		dedupe:       newDeduper(cfg.Cache, cfg.DedupTTL),
+		maxBodyBytes: cfg.MaxBodyBytes,
+		audit:        cfg.AuditLogger,
+	}
+
+	for name, providerCfg := range cfg.Providers {
+		if !providerCfg.Enabled {
+			continue
+		}
+		p, err := newProvider(name, providerCfg)
+		if err != nil {
+			return nil, err
+		}
+		h.providers[name] = p
+	}
+
+	return h, nil
+}
+
+// Enabled returns the names of the providers that can deliver webhooks
+func (h *Handler) Enabled() []string {
+	names := make([]string, 0, len(h.providers))
+	for name := range h.providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// OnEvent registers a function called in-process for every accepted event, e.g.
+// to trigger a sync. It runs on the request path, so it must not block.
+func (h *Handler) OnEvent(fn func(Event)) {
+	h.mu.Lock()
+	h.listeners = append(h.listeners, fn)
+	h.mu.Unlock()
+}
+
+// Receive handles POST /webhooks/{provider}
+func (h *Handler) Receive(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	name := chi.URLParam(r, "provider")
+	p, ok := h.providers[name]
+	if !ok {
+		h.respondError(w, http.StatusNotFound, "unknown or disabled webhook provider")
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, h.maxBodyBytes))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			h.respondError(w, http.StatusRequestEntityTooLarge, "payload too large")
+			return
+		}
+		h.respondError(w, http.StatusBadRequest, "failed to read payload")
+		return
+	}
+
+	if err := p.verify(r, body); err != nil {
+		if h.audit != nil {
+			h.audit.LogAuthFailure(r, "webhook_"+name+": "+err.Error())
+		}
+		h.respondError(w, http.StatusUnauthorized, "invalid signature")
+		return
+	}
+
+	id, eventType := p.identify(body)
+	action := "webhook_" + name
+
+	claim, err := h.dedupe.claim(r.Context(), name, id)
+	if err != nil {
+		h.fail(w, r, action, http.StatusServiceUnavailable, err, start)
+		return
+	}
+	switch claim {
+	case claimDelivered:
+		h.respondJSON(w, http.StatusOK, map[string]string{"status": "duplicate", "event_id": id})
+		return
+	case claimInProgress:
+		// Ask the provider to retry in case the other delivery fails
+		w.Header().Set("Retry-After", "5")
+		h.respondError(w, http.StatusConflict, "event is already being processed")
+		return
+	}
+
+	// Payloads that are not JSON are kept as a JSON string
+	payload := json.RawMessage(body)
+	if !json.Valid(body) {
+		payload, _ = json.Marshal(string(body))
+	}
+	event := Event{
+		ID:         id,
+		Provider:   name,
+		Type:       eventType,
+		ReceivedAt: start.UTC(),
+		Payload:    payload,
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), publishTimeout)
+	defer cancel()
+	if err := h.queue.Publish(ctx, event); err != nil {
+		h.dedupe.release(context.WithoutCancel(r.Context()), name, id)
+		h.fail(w, r, action, http.StatusServiceUnavailable, err, start)
+		return
+	}
+	if err := h.dedupe.delivered(context.WithoutCancel(r.Context()), name, id); err != nil {
+		// The event is queued; a redelivery may be queued again and is
+		// dropped by consumers that track event IDs
+		if h.audit != nil {
+			h.audit.GetLogger().Warn("failed to record webhook delivery",
+				zap.String("provider", name),
+				zap.String("event_id", id),
+				zap.Error(err),
+			)
+		}
+	}
+
+	h.mu.RLock()
+	listeners := h.listeners
+	h.mu.RUnlock()
+	for _, fn := range listeners {
+		fn(event)
+	}
+
+	if h.audit != nil {
+		h.audit.LogHTTPRequest(r, action, true, nil, time.Since(start))
+	}
+	h.respondJSON(w, http.StatusAccepted, map[string]string{"status": "accepted", "event_id": id})
+}
+
+// Helper functions
+
+// fail audits a failed delivery and responds with the error
+func (h *Handler) fail(w http.ResponseWriter, r *http.Request, action string, status int, err error, start time.Time) {
+	if h.audit != nil {
+		h.audit.LogHTTPRequest(r, action, false, err, time.Since(start))
+	}
+	h.respondError(w, status, err.Error())
+}
+
+// respondError writes a JSON error response
+func (h *Handler) respondError(w http.ResponseWriter, status int, message string) {
+	h.respondJSON(w, status, map[string]string{
+		"error": message,
+	})
+}
+
+// respondJSON writes a JSON response
+func (h *Handler) respondJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
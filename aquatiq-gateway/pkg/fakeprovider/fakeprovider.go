@@ -0,0 +1,323 @@
+// Package fakeprovider is an in-memory stand-in for the SuperOffice and
+// Visma.net REST APIs and their OAuth2 token endpoints. It implements the
+// subset of the APIs the gateway uses and can inject faults (status codes,
+// latency, expired tokens), so integration code can be exercised end to end
+// without network access. Embed it with httptest.NewServer(fakeprovider.New(cfg))
+// or run cmd/fakeprovider.
+//
+// Routes:
+//
+//	/{tenant}/api/v1/{Entity}[/{id}]              SuperOffice (Contact, Person, Sale, Project, Appointment)
+//	/login/common/oauth/{authorize,tokens}        SuperOffice OAuth2
+//	/controller/api/v1/{entity}[/{key}]           Visma.net (customer, supplier, customerinvoice, inventory, project)
+//	/connect/{authorize,token}                    Visma OAuth2
+//	/_fake/...                                    Faults, tokens, seed data and stats
+package fakeprovider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// Provider names
+const (
+	SuperOffice = "superoffice"
+	Visma       = "visma"
+)
+
+// Config holds fake provider configuration
+type Config struct {
+	Clients        map[string]string // OAuth client ID → secret; any client is accepted when empty
+	TokenTTL       time.Duration     // Access token lifetime (default 1h)
+	SkipAuth       bool              // Serve API calls without a bearer token
+	VismaCompanies []string          // Companies that can be accessed; any when empty
+	PageSize       int               // SuperOffice page size when $top is not set (default 50)
+	Now            func() time.Time  // Clock for timestamps and token expiry (default time.Now)
+}
+
+// Stats counts what the fake has served
+type Stats struct {
+	Requests     map[string]int `json:"requests"`      // API and token requests per provider
+	Faults       int            `json:"faults"`        // Requests answered by an injected fault
+	TokensIssued map[string]int `json:"tokens_issued"` // Access tokens issued per provider
+	Unauthorized int            `json:"unauthorized"`  // API calls rejected for a missing or expired token
+	Records      map[string]int `json:"records"`       // Stored records per {provider}/{tenant or company}/{entity}
+}
+
+// SeedData is initial data, keyed by tenant (SuperOffice) or company (Visma),
+// then by entity. Records use the shape the APIs return.
+type SeedData struct {
+	SuperOffice map[string]map[string][]map[string]interface{} `json:"superoffice"`
+	Visma       map[string]map[string][]map[string]interface{} `json:"visma"`
+}
+
+// Server is a fake SuperOffice and Visma.net API
+type Server struct {
+	router http.Handler
+	cfg    Config
+
+	mu          sync.Mutex
+	collections map[string]*collection
+	tokens      *tokenStore
+	faults      []*Fault
+	nextFault   int
+	stats       Stats
+}
+
+// New creates a fake provider server
+func New(cfg Config) *Server {
+	if cfg.TokenTTL == 0 {
+		cfg.TokenTTL = time.Hour
+	}
+	if cfg.PageSize == 0 {
+		cfg.PageSize = 50
+	}
+	if cfg.Now == nil {
+		cfg.Now = time.Now
+	}
+
+	s := &Server{cfg: cfg}
+	s.reset()
+
+	r := chi.NewRouter()
+	r.Route("/_fake", s.adminRoutes)
+
+	// SuperOffice
+	r.Group(func(r chi.Router) {
+		r.Use(s.provider(SuperOffice))
+		r.Get("/login/common/oauth/authorize", s.authorize(SuperOffice))
+		r.Post("/login/common/oauth/tokens", s.token(SuperOffice))
+		r.Group(func(r chi.Router) {
+			r.Use(s.requireToken(SuperOffice))
+			s.superOfficeRoutes(r)
+		})
+	})
+
+	// Visma.net
+	r.Group(func(r chi.Router) {
+		r.Use(s.provider(Visma))
+		r.Get("/connect/authorize", s.authorize(Visma))
+		r.Post("/connect/token", s.token(Visma))
+		r.Group(func(r chi.Router) {
+			r.Use(s.requireToken(Visma))
+			r.Use(s.requireCompany)
+			s.vismaRoutes(r)
+		})
+	})
+
+	s.router = r
+	return s
+}
+
+// ServeHTTP implements http.Handler
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.router.ServeHTTP(w, r)
+}
+
+// Reset drops all records, tokens, faults and stats
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reset()
+}
+
+// Seed stores initial records
+func (s *Server) Seed(data SeedData) error {
+	for tenant, entities := range data.SuperOffice {
+		for entity, records := range entities {
+			for _, rec := range records {
+				if _, err := s.createSuperOffice(tenant, entity, record(rec).clone()); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	for company, entities := range data.Visma {
+		for entity, records := range entities {
+			for _, rec := range records {
+				if _, err := s.createVisma(company, entity, record(rec).clone()); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Records returns a copy of the stored records of an entity, in insertion order.
+// scope is the tenant for SuperOffice and the company for Visma.
+func (s *Server) Records(provider, scope, entity string) []map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.collections[collectionKey(provider, scope, entity)]
+	if !ok {
+		return nil
+	}
+	records := make([]map[string]interface{}, len(c.records))
+	for i, rec := range c.records {
+		records[i] = rec.clone()
+	}
+	return records
+}
+
+// Stats returns request, fault and token counters
+func (s *Server) Stats() Stats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats := Stats{
+		Requests:     make(map[string]int, len(s.stats.Requests)),
+		Faults:       s.stats.Faults,
+		TokensIssued: make(map[string]int, len(s.stats.TokensIssued)),
+		Unauthorized: s.stats.Unauthorized,
+		Records:      make(map[string]int, len(s.collections)),
+	}
+	for k, v := range s.stats.Requests {
+		stats.Requests[k] = v
+	}
+	for k, v := range s.stats.TokensIssued {
+		stats.TokensIssued[k] = v
+	}
+	for k, c := range s.collections {
+		stats.Records[k] = len(c.records)
+	}
+	return stats
+}
+
+// adminRoutes registers the control API used by cmd/fakeprovider and scripts
+func (s *Server) adminRoutes(r chi.Router) {
+	r.Get("/state", func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(w, http.StatusOK, s.Stats())
+	})
+
+	r.Post("/reset", func(w http.ResponseWriter, r *http.Request) {
+		s.Reset()
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r.Post("/seed", func(w http.ResponseWriter, r *http.Request) {
+		var data SeedData
+		if err := decodeJSON(r, &data); err != nil {
+			respondJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+			return
+		}
+		if err := s.Seed(data); err != nil {
+			respondJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r.Get("/faults", func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(w, http.StatusOK, s.Faults())
+	})
+
+	r.Post("/faults", func(w http.ResponseWriter, r *http.Request) {
+		var req faultRequest
+		if err := decodeJSON(r, &req); err != nil {
+			respondJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+			return
+		}
+		fault, err := req.fault()
+		if err != nil {
+			respondJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+			return
+		}
+		respondJSON(w, http.StatusCreated, s.AddFault(fault))
+	})
+
+	r.Delete("/faults", func(w http.ResponseWriter, r *http.Request) {
+		s.ClearFaults()
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r.Delete("/faults/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if !s.RemoveFault(chi.URLParam(r, "id")) {
+			respondJSON(w, http.StatusNotFound, map[string]string{"error": "unknown fault"})
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r.Post("/tokens/expire", func(w http.ResponseWriter, r *http.Request) {
+		n := s.ExpireTokens(r.URL.Query().Get("provider"))
+		respondJSON(w, http.StatusOK, map[string]int{"expired": n})
+	})
+
+	r.Post("/tokens/revoke", func(w http.ResponseWriter, r *http.Request) {
+		n := s.RevokeTokens(r.URL.Query().Get("provider"))
+		respondJSON(w, http.StatusOK, map[string]int{"revoked": n})
+	})
+}
+
+// provider counts requests to a provider and applies matching faults
+func (s *Server) provider(name string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			s.mu.Lock()
+			s.stats.Requests[name]++
+			s.mu.Unlock()
+
+			if s.applyFault(w, r, name) {
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// Helper functions
+
+// reset initializes all state; the caller holds the lock unless the server is new
+func (s *Server) reset() {
+	s.collections = make(map[string]*collection)
+	s.tokens = newTokenStore()
+	s.faults = nil
+	s.stats = Stats{
+		Requests:     make(map[string]int),
+		TokensIssued: make(map[string]int),
+	}
+}
+
+// baseURL returns the scheme and host the request was sent to
+func baseURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host
+}
+
+// matchName returns the known name matching a path segment case-insensitively
+func matchName(segment string, known []string) (string, bool) {
+	for _, name := range known {
+		if strings.EqualFold(segment, name) {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// decodeJSON decodes a request body, keeping numbers as json.Number
+func decodeJSON(r *http.Request, v interface{}) error {
+	dec := json.NewDecoder(http.MaxBytesReader(nil, r.Body, 10<<20))
+	dec.UseNumber()
+	if err := dec.Decode(v); err != nil {
+		return fmt.Errorf("invalid JSON body: %w", err)
+	}
+	return nil
+}
+
+// respondJSON writes a JSON response
+func respondJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,198 @@
+package fakeprovider
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Fault changes how matching requests are answered. Latency is applied first;
+// a non-zero Status then replaces the real response.
+type Fault struct {
+	ID          string        `json:"id"`
+	Provider    string        `json:"provider,omitempty"`    // superoffice, visma or empty for both
+	Method      string        `json:"method,omitempty"`      // Empty for all methods
+	Path        string        `json:"path,omitempty"`        // Path prefix, e.g. /controller/api/v1/customer
+	Status      int           `json:"status,omitempty"`      // e.g. 429, 500, 503
+	RetryAfter  time.Duration `json:"retry_after,omitempty"` // Sent as Retry-After with the status
+	Latency     time.Duration `json:"latency,omitempty"`     // Delay before responding
+	Probability float64       `json:"probability,omitempty"` // Share of matching requests affected; 0 means all
+	Times       int           `json:"times,omitempty"`       // Requests affected before the fault is removed; 0 means unlimited
+	Hits        int           `json:"hits"`                  // Requests affected so far
+}
+
+// MarshalJSON writes durations the way /_fake/faults accepts them, e.g. "500ms"
+func (f Fault) MarshalJSON() ([]byte, error) {
+	type fault Fault
+	out := struct {
+		fault
+		RetryAfter string `json:"retry_after,omitempty"`
+		Latency    string `json:"latency,omitempty"`
+	}{fault: fault(f)}
+	if f.RetryAfter > 0 {
+		out.RetryAfter = f.RetryAfter.String()
+	}
+	if f.Latency > 0 {
+		out.Latency = f.Latency.String()
+	}
+	return json.Marshal(out)
+}
+
+// faultRequest is a fault as posted to /_fake/faults, with durations such as "500ms"
+type faultRequest struct {
+	Provider    string  `json:"provider"`
+	Method      string  `json:"method"`
+	Path        string  `json:"path"`
+	Status      int     `json:"status"`
+	RetryAfter  string  `json:"retry_after"`
+	Latency     string  `json:"latency"`
+	Probability float64 `json:"probability"`
+	Times       int     `json:"times"`
+}
+
+// fault converts the request to a Fault
+func (req faultRequest) fault() (Fault, error) {
+	f := Fault{
+		Provider:    req.Provider,
+		Method:      strings.ToUpper(req.Method),
+		Path:        req.Path,
+		Status:      req.Status,
+		Probability: req.Probability,
+		Times:       req.Times,
+	}
+
+	var err error
+	if req.RetryAfter != "" {
+		if f.RetryAfter, err = time.ParseDuration(req.RetryAfter); err != nil {
+			return f, fmt.Errorf("invalid retry_after: %w", err)
+		}
+	}
+	if req.Latency != "" {
+		if f.Latency, err = time.ParseDuration(req.Latency); err != nil {
+			return f, fmt.Errorf("invalid latency: %w", err)
+		}
+	}
+	if f.Provider != "" && f.Provider != SuperOffice && f.Provider != Visma {
+		return f, fmt.Errorf("provider must be %s or %s", SuperOffice, Visma)
+	}
+	if f.Status == 0 && f.Latency == 0 {
+		return f, fmt.Errorf("a fault needs a status or a latency")
+	}
+	if f.Status != 0 && (f.Status < 400 || f.Status > 599) {
+		return f, fmt.Errorf("status must be an error status")
+	}
+	return f, nil
+}
+
+// AddFault starts injecting a fault and returns it with its ID
+func (s *Server) AddFault(f Fault) Fault {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nextFault++
+	f.ID = strconv.Itoa(s.nextFault)
+	f.Method = strings.ToUpper(f.Method)
+	f.Hits = 0
+	s.faults = append(s.faults, &f)
+	return f
+}
+
+// RemoveFault stops injecting a fault; it reports whether the fault existed
+func (s *Server) RemoveFault(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, f := range s.faults {
+		if f.ID == id {
+			s.faults = append(s.faults[:i], s.faults[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// ClearFaults removes all faults
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	s.faults = nil
+	s.mu.Unlock()
+}
+
+// Faults returns the active faults
+func (s *Server) Faults() []Fault {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	faults := make([]Fault, len(s.faults))
+	for i, f := range s.faults {
+		faults[i] = *f
+	}
+	return faults
+}
+
+// applyFault applies the first matching fault; it reports whether the response was written
+func (s *Server) applyFault(w http.ResponseWriter, r *http.Request, provider string) bool {
+	s.mu.Lock()
+	var fault *Fault
+	for i, f := range s.faults {
+		if !f.matches(r, provider) {
+			continue
+		}
+		if f.Probability > 0 && rand.Float64() >= f.Probability {
+			continue
+		}
+		f.Hits++
+		applied := *f
+		fault = &applied
+		if f.Times > 0 && f.Hits >= f.Times {
+			s.faults = append(s.faults[:i], s.faults[i+1:]...)
+		}
+		if f.Status != 0 {
+			s.stats.Faults++
+		}
+		break
+	}
+	s.mu.Unlock()
+
+	if fault == nil {
+		return false
+	}
+
+	if fault.Latency > 0 {
+		timer := time.NewTimer(fault.Latency)
+		select {
+		case <-timer.C:
+		case <-r.Context().Done():
+			timer.Stop()
+			return true
+		}
+	}
+	if fault.Status == 0 {
+		return false
+	}
+
+	if fault.RetryAfter > 0 {
+		seconds := int((fault.RetryAfter + time.Second - 1) / time.Second)
+		w.Header().Set("Retry-After", strconv.Itoa(seconds))
+	}
+	respondJSON(w, fault.Status, map[string]string{
+		"error":   http.StatusText(fault.Status),
+		"message": "injected fault " + fault.ID,
+	})
+	return true
+}
+
+// matches reports whether a fault applies to a request
+func (f *Fault) matches(r *http.Request, provider string) bool {
+	if f.Provider != "" && f.Provider != provider {
+		return false
+	}
+	if f.Method != "" && f.Method != r.Method {
+		return false
+	}
+	return f.Path == "" || strings.HasPrefix(strings.ToLower(r.URL.Path), strings.ToLower(f.Path))
+}
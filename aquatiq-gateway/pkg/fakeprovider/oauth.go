@@ -0,0 +1,291 @@
+package fakeprovider
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// codeTTL is how long an authorization code can be exchanged
+const codeTTL = time.Minute
+
+// tokenStore holds issued codes and tokens
+type tokenStore struct {
+	access  map[string]accessToken
+	refresh map[string]string // Refresh token → provider
+	codes   map[string]authCode
+}
+
+// accessToken is an issued access token
+type accessToken struct {
+	provider  string
+	expiresAt time.Time
+}
+
+// authCode is an issued authorization code
+type authCode struct {
+	provider      string
+	clientID      string
+	redirectURI   string
+	challenge     string
+	challengeType string
+	scope         string
+	expiresAt     time.Time
+}
+
+// newTokenStore creates an empty token store
+func newTokenStore() *tokenStore {
+	return &tokenStore{
+		access:  make(map[string]accessToken),
+		refresh: make(map[string]string),
+		codes:   make(map[string]authCode),
+	}
+}
+
+// ExpireTokens expires the access tokens of a provider (both when empty), so
+// the next API call gets a 401 and the client has to refresh. It returns the
+// number of tokens expired.
+func (s *Server) ExpireTokens(provider string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	expired := 0
+	now := s.cfg.Now()
+	for token, t := range s.tokens.access {
+		if (provider == "" || t.provider == provider) && t.expiresAt.After(now) {
+			t.expiresAt = now
+			s.tokens.access[token] = t
+			expired++
+		}
+	}
+	return expired
+}
+
+// RevokeTokens drops the access and refresh tokens of a provider (both when
+// empty), so refreshing fails and users have to authorize again. It returns
+// the number of tokens revoked.
+func (s *Server) RevokeTokens(provider string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	revoked := 0
+	for token, t := range s.tokens.access {
+		if provider == "" || t.provider == provider {
+			delete(s.tokens.access, token)
+			revoked++
+		}
+	}
+	for token, p := range s.tokens.refresh {
+		if provider == "" || p == provider {
+			delete(s.tokens.refresh, token)
+			revoked++
+		}
+	}
+	return revoked
+}
+
+// authorize approves every authorization request and redirects straight back with a code
+func (s *Server) authorize(provider string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		redirectURI := query.Get("redirect_uri")
+		if query.Get("response_type") != "code" || redirectURI == "" {
+			respondJSON(w, http.StatusBadRequest, oauthError("invalid_request", "response_type=code and redirect_uri are required"))
+			return
+		}
+		if !s.knownClient(query.Get("client_id"), "", false) {
+			respondJSON(w, http.StatusBadRequest, oauthError("unauthorized_client", "unknown client"))
+			return
+		}
+		target, err := url.Parse(redirectURI)
+		if err != nil {
+			respondJSON(w, http.StatusBadRequest, oauthError("invalid_request", "invalid redirect_uri"))
+			return
+		}
+
+		code := randomToken()
+		s.mu.Lock()
+		s.tokens.codes[code] = authCode{
+			provider:      provider,
+			clientID:      query.Get("client_id"),
+			redirectURI:   redirectURI,
+			challenge:     query.Get("code_challenge"),
+			challengeType: query.Get("code_challenge_method"),
+			scope:         query.Get("scope"),
+			expiresAt:     s.cfg.Now().Add(codeTTL),
+		}
+		s.mu.Unlock()
+
+		params := target.Query()
+		params.Set("code", code)
+		if state := query.Get("state"); state != "" {
+			params.Set("state", state)
+		}
+		target.RawQuery = params.Encode()
+		http.Redirect(w, r, target.String(), http.StatusFound)
+	}
+}
+
+// token implements the token endpoint for the authorization_code,
+// refresh_token and client_credentials grants
+func (s *Server) token(provider string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			respondJSON(w, http.StatusBadRequest, oauthError("invalid_request", err.Error()))
+			return
+		}
+		clientID, clientSecret, ok := r.BasicAuth()
+		if !ok {
+			clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		now := s.cfg.Now()
+		scope := r.PostForm.Get("scope")
+		withRefresh := true
+
+		switch r.PostForm.Get("grant_type") {
+		case "client_credentials":
+			if !s.knownClient(clientID, clientSecret, true) {
+				respondJSON(w, http.StatusUnauthorized, oauthError("invalid_client", "client authentication failed"))
+				return
+			}
+			withRefresh = false
+
+		case "authorization_code":
+			code, ok := s.tokens.codes[r.PostForm.Get("code")]
+			delete(s.tokens.codes, r.PostForm.Get("code"))
+			if !ok || code.provider != provider || now.After(code.expiresAt) {
+				respondJSON(w, http.StatusBadRequest, oauthError("invalid_grant", "unknown or expired code"))
+				return
+			}
+			if code.clientID != clientID || !s.knownClient(clientID, clientSecret, true) {
+				respondJSON(w, http.StatusUnauthorized, oauthError("invalid_client", "client authentication failed"))
+				return
+			}
+			if code.redirectURI != r.PostForm.Get("redirect_uri") {
+				respondJSON(w, http.StatusBadRequest, oauthError("invalid_grant", "redirect_uri does not match"))
+				return
+			}
+			if !verifyChallenge(code, r.PostForm.Get("code_verifier")) {
+				respondJSON(w, http.StatusBadRequest, oauthError("invalid_grant", "code_verifier does not match"))
+				return
+			}
+			scope = code.scope
+
+		case "refresh_token":
+			refresh := r.PostForm.Get("refresh_token")
+			if p, ok := s.tokens.refresh[refresh]; !ok || p != provider {
+				respondJSON(w, http.StatusBadRequest, oauthError("invalid_grant", "unknown or revoked refresh token"))
+				return
+			}
+			if !s.knownClient(clientID, clientSecret, true) {
+				respondJSON(w, http.StatusUnauthorized, oauthError("invalid_client", "client authentication failed"))
+				return
+			}
+			// Refresh tokens rotate
+			delete(s.tokens.refresh, refresh)
+
+		default:
+			respondJSON(w, http.StatusBadRequest, oauthError("unsupported_grant_type", "unsupported grant type"))
+			return
+		}
+
+		access := randomToken()
+		s.tokens.access[access] = accessToken{provider: provider, expiresAt: now.Add(s.cfg.TokenTTL)}
+		s.stats.TokensIssued[provider]++
+
+		resp := map[string]interface{}{
+			"access_token": access,
+			"token_type":   "Bearer",
+			"expires_in":   int(s.cfg.TokenTTL / time.Second),
+		}
+		if scope != "" {
+			resp["scope"] = scope
+		}
+		if withRefresh {
+			refresh := randomToken()
+			s.tokens.refresh[refresh] = provider
+			resp["refresh_token"] = refresh
+		}
+		w.Header().Set("Cache-Control", "no-store")
+		respondJSON(w, http.StatusOK, resp)
+	}
+}
+
+// requireToken rejects API calls without a valid access token of the provider
+func (s *Server) requireToken(provider string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if s.cfg.SkipAuth {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			s.mu.Lock()
+			t, ok := s.tokens.access[token]
+			valid := found && ok && t.provider == provider && s.cfg.Now().Before(t.expiresAt)
+			if !valid {
+				s.stats.Unauthorized++
+			}
+			s.mu.Unlock()
+
+			if !valid {
+				w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+				respondJSON(w, http.StatusUnauthorized, oauthError("invalid_token", "access token is missing, unknown or expired"))
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// Helper functions
+
+// knownClient checks a client ID, and its secret when checkSecret is set.
+// Every client is accepted when none are configured.
+func (s *Server) knownClient(clientID, clientSecret string, checkSecret bool) bool {
+	if len(s.cfg.Clients) == 0 {
+		return true
+	}
+	secret, ok := s.cfg.Clients[clientID]
+	if !ok {
+		return false
+	}
+	return !checkSecret || subtle.ConstantTimeCompare([]byte(secret), []byte(clientSecret)) == 1
+}
+
+// verifyChallenge checks a PKCE code verifier (RFC 7636); codes issued without a challenge need none
+func verifyChallenge(code authCode, verifier string) bool {
+	switch code.challengeType {
+	case "":
+		return code.challenge == "" || code.challenge == verifier
+	case "plain":
+		return code.challenge == verifier
+	case "S256":
+		sum := sha256.Sum256([]byte(verifier))
+		return base64.RawURLEncoding.EncodeToString(sum[:]) == code.challenge
+	default:
+		return false
+	}
+}
+
+// oauthError builds an OAuth2 error response body
+func oauthError(code, description string) map[string]string {
+	return map[string]string{"error": code, "error_description": description}
+}
+
+// randomToken returns a random opaque token
+func randomToken() string {
+	b := make([]byte, 24)
+	_, _ = rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,158 @@
+package fakeprovider
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// record is a stored entity as its JSON object
+type record map[string]interface{}
+
+// collection holds the records of one entity type for one tenant or company
+type collection struct {
+	records []record
+	nextID  int
+}
+
+// collectionKey identifies a collection
+func collectionKey(provider, scope, entity string) string {
+	return provider + "/" + scope + "/" + entity
+}
+
+// collection returns a collection, creating it when needed; the caller holds the lock
+func (s *Server) collection(provider, scope, entity string) *collection {
+	key := collectionKey(provider, scope, entity)
+	c, ok := s.collections[key]
+	if !ok {
+		c = &collection{}
+		s.collections[key] = c
+	}
+	return c
+}
+
+// find returns the index of the record whose field equals value, or -1
+func (c *collection) find(field, value string) int {
+	for i, rec := range c.records {
+		if v, ok := rec.lookup(field); ok && valueString(v) == value {
+			return i
+		}
+	}
+	return -1
+}
+
+// lookup returns a field, matching its name case-insensitively as the APIs do
+func (r record) lookup(name string) (interface{}, bool) {
+	if v, ok := r[name]; ok {
+		return v, true
+	}
+	for key, v := range r {
+		if strings.EqualFold(key, name) {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+// clone returns a deep copy of the record
+func (r record) clone() record {
+	data, _ := json.Marshal(r)
+	var out record
+	dec := json.NewDecoder(strings.NewReader(string(data)))
+	dec.UseNumber()
+	_ = dec.Decode(&out)
+	return out
+}
+
+// project keeps only the selected fields; all fields are kept when none are selected
+func (r record) project(fields []string) record {
+	if len(fields) == 0 {
+		return r
+	}
+	out := record{}
+	for _, field := range fields {
+		for key, v := range r {
+			if strings.EqualFold(key, strings.TrimSpace(field)) {
+				out[key] = v
+			}
+		}
+	}
+	return out
+}
+
+// valueString formats a scalar field for comparison
+func valueString(v interface{}) string {
+	switch typed := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return typed
+	case json.Number:
+		return typed.String()
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64)
+	default:
+		return fmt.Sprint(typed)
+	}
+}
+
+// valueNumber returns a numeric field
+func valueNumber(v interface{}) (float64, bool) {
+	switch typed := v.(type) {
+	case json.Number:
+		f, err := typed.Float64()
+		return f, err == nil
+	case float64:
+		return typed, true
+	case int:
+		return float64(typed), true
+	case string:
+		f, err := strconv.ParseFloat(typed, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// timeLayouts are the timestamp formats both APIs use; zone-less times are UTC
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02",
+}
+
+// valueTime parses a timestamp field
+func valueTime(v interface{}) (time.Time, bool) {
+	s, ok := v.(string)
+	if !ok {
+		return time.Time{}, false
+	}
+	for _, layout := range timeLayouts {
+		if t, err := time.ParseInLocation(layout, s, time.UTC); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// compareValues orders two field values as times, numbers or strings
+func compareValues(a, b interface{}) int {
+	if ta, ok := valueTime(a); ok {
+		if tb, ok := valueTime(b); ok {
+			return ta.Compare(tb)
+		}
+	}
+	if na, ok := valueNumber(a); ok {
+		if nb, ok := valueNumber(b); ok {
+			switch {
+			case na < nb:
+				return -1
+			case na > nb:
+				return 1
+			}
+			return 0
+		}
+	}
+	return strings.Compare(strings.ToLower(valueString(a)), strings.ToLower(valueString(b)))
+}
@@ -0,0 +1,389 @@
+package fakeprovider
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// superOfficeEntities are the SuperOffice entities served, with their ID fields
+var superOfficeEntities = map[string]string{
+	"Contact":     "ContactId",
+	"Person":      "PersonId",
+	"Sale":        "SaleId",
+	"Project":     "ProjectId",
+	"Appointment": "AppointmentId",
+}
+
+// superOfficeTime is how SuperOffice formats timestamps (UTC, no zone)
+const superOfficeTime = "2006-01-02T15:04:05"
+
+// superOfficeRoutes registers the SuperOffice REST API
+func (s *Server) superOfficeRoutes(r chi.Router) {
+	r.Get("/{tenant}/api/v1/{entity}", s.superOfficeList)
+	r.Post("/{tenant}/api/v1/{entity}", s.superOfficeCreate)
+	r.Get("/{tenant}/api/v1/{entity}/{id}", s.superOfficeGet)
+	r.Put("/{tenant}/api/v1/{entity}/{id}", s.superOfficeUpdate)
+	r.Delete("/{tenant}/api/v1/{entity}/{id}", s.superOfficeDelete)
+}
+
+// superOfficeList serves OData list requests: $filter (eq, ne, contains, gt,
+// lt, afterTime and beforeTime combined with "and"), $orderby, $select, $top and $skip
+func (s *Server) superOfficeList(w http.ResponseWriter, r *http.Request) {
+	tenant, entity, ok := superOfficeEntity(w, r)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+	conditions, err := parseFilter(query.Get("$filter"))
+	if err != nil {
+		superOfficeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	top, err := queryInt(query, "$top", s.cfg.PageSize)
+	if err != nil {
+		superOfficeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	skip, err := queryInt(query, "$skip", 0)
+	if err != nil {
+		superOfficeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	var matched []record
+	for _, rec := range s.collection(SuperOffice, tenant, entity).records {
+		if matchesAll(rec, conditions) {
+			matched = append(matched, rec.clone())
+		}
+	}
+	s.mu.Unlock()
+
+	if orderBy := strings.Fields(query.Get("$orderby")); len(orderBy) > 0 {
+		desc := len(orderBy) > 1 && strings.EqualFold(orderBy[1], "desc")
+		sort.SliceStable(matched, func(i, j int) bool {
+			a, _ := matched[i].lookup(orderBy[0])
+			b, _ := matched[j].lookup(orderBy[0])
+			if desc {
+				return compareValues(a, b) > 0
+			}
+			return compareValues(a, b) < 0
+		})
+	}
+
+	var selected []string
+	if sel := query.Get("$select"); sel != "" {
+		selected = strings.Split(sel, ",")
+	}
+	page := []record{}
+	for i := skip; i < len(matched) && i < skip+top; i++ {
+		page = append(page, matched[i].project(selected))
+	}
+
+	resp := map[string]interface{}{"value": page}
+	if skip+top < len(matched) {
+		next := url.Values{}
+		for key, values := range query {
+			next[key] = values
+		}
+		next.Set("$top", strconv.Itoa(top))
+		next.Set("$skip", strconv.Itoa(skip+top))
+		resp["odata.nextLink"] = baseURL(r) + r.URL.Path + "?" + next.Encode()
+	}
+	respondJSON(w, http.StatusOK, resp)
+}
+
+// superOfficeGet returns one entity
+func (s *Server) superOfficeGet(w http.ResponseWriter, r *http.Request) {
+	tenant, entity, ok := superOfficeEntity(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	c := s.collection(SuperOffice, tenant, entity)
+	i := c.find(superOfficeEntities[entity], chi.URLParam(r, "id"))
+	var rec record
+	if i >= 0 {
+		rec = c.records[i].clone()
+	}
+	s.mu.Unlock()
+
+	if rec == nil {
+		superOfficeError(w, http.StatusNotFound, fmt.Sprintf("%s %s not found", entity, chi.URLParam(r, "id")))
+		return
+	}
+	respondJSON(w, http.StatusOK, rec)
+}
+
+// superOfficeCreate stores a new entity and returns it
+func (s *Server) superOfficeCreate(w http.ResponseWriter, r *http.Request) {
+	tenant, entity, ok := superOfficeEntity(w, r)
+	if !ok {
+		return
+	}
+
+	var rec record
+	if err := decodeJSON(r, &rec); err != nil {
+		superOfficeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	// The server assigns IDs and timestamps
+	for _, field := range []string{superOfficeEntities[entity], "CreatedDate", "UpdatedDate"} {
+		delete(rec, field)
+	}
+
+	created, err := s.createSuperOffice(tenant, entity, rec)
+	if err != nil {
+		superOfficeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	respondJSON(w, http.StatusCreated, created)
+}
+
+// superOfficeUpdate replaces an entity and returns it
+func (s *Server) superOfficeUpdate(w http.ResponseWriter, r *http.Request) {
+	tenant, entity, ok := superOfficeEntity(w, r)
+	if !ok {
+		return
+	}
+
+	var rec record
+	if err := decodeJSON(r, &rec); err != nil {
+		superOfficeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	c := s.collection(SuperOffice, tenant, entity)
+	idField := superOfficeEntities[entity]
+	i := c.find(idField, chi.URLParam(r, "id"))
+	if i >= 0 {
+		existing := c.records[i]
+		rec[idField] = existing[idField]
+		rec["CreatedDate"] = existing["CreatedDate"]
+		rec["UpdatedDate"] = s.cfg.Now().UTC().Format(superOfficeTime)
+		c.records[i] = rec
+		rec = rec.clone()
+	}
+	s.mu.Unlock()
+
+	if i < 0 {
+		superOfficeError(w, http.StatusNotFound, fmt.Sprintf("%s %s not found", entity, chi.URLParam(r, "id")))
+		return
+	}
+	respondJSON(w, http.StatusOK, rec)
+}
+
+// superOfficeDelete removes an entity
+func (s *Server) superOfficeDelete(w http.ResponseWriter, r *http.Request) {
+	tenant, entity, ok := superOfficeEntity(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	c := s.collection(SuperOffice, tenant, entity)
+	i := c.find(superOfficeEntities[entity], chi.URLParam(r, "id"))
+	if i >= 0 {
+		c.records = append(c.records[:i], c.records[i+1:]...)
+	}
+	s.mu.Unlock()
+
+	if i < 0 {
+		superOfficeError(w, http.StatusNotFound, fmt.Sprintf("%s %s not found", entity, chi.URLParam(r, "id")))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// createSuperOffice stores an entity, assigning an ID unless it has one, and timestamps
+func (s *Server) createSuperOffice(tenant, name string, rec record) (record, error) {
+	entity, ok := matchName(name, superOfficeNames())
+	if !ok {
+		return nil, fmt.Errorf("unknown SuperOffice entity %q", name)
+	}
+	idField := superOfficeEntities[entity]
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c := s.collection(SuperOffice, tenant, entity)
+	if v, ok := rec.lookup(idField); ok && valueString(v) != "" && valueString(v) != "0" {
+		id, err := strconv.Atoi(valueString(v))
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %v", idField, v)
+		}
+		if c.find(idField, strconv.Itoa(id)) >= 0 {
+			return nil, fmt.Errorf("%s %d already exists", entity, id)
+		}
+		if id > c.nextID {
+			c.nextID = id
+		}
+		rec[idField] = id
+	} else {
+		c.nextID++
+		rec[idField] = c.nextID
+	}
+
+	// Seed data may bring its own timestamps
+	now := s.cfg.Now().UTC().Format(superOfficeTime)
+	for _, field := range []string{"CreatedDate", "UpdatedDate"} {
+		if v, ok := rec.lookup(field); !ok || v == nil {
+			rec[field] = now
+		}
+	}
+	c.records = append(c.records, rec)
+	return rec.clone(), nil
+}
+
+// condition is one comparison of an OData filter
+type condition struct {
+	field string
+	op    string
+	value string
+}
+
+// parseFilter parses filters joined with "and", as built by the superoffice package
+func parseFilter(filter string) ([]condition, error) {
+	if strings.TrimSpace(filter) == "" {
+		return nil, nil
+	}
+
+	if len(splitOutsideQuotes(filter, " or ")) > 1 {
+		return nil, fmt.Errorf("\"or\" filters are not supported")
+	}
+
+	var conditions []condition
+	for _, part := range splitOutsideQuotes(filter, " and ") {
+		part = strings.TrimSpace(part)
+		for strings.HasPrefix(part, "(") && strings.HasSuffix(part, ")") {
+			part = strings.TrimSpace(part[1 : len(part)-1])
+		}
+
+		fields := strings.SplitN(part, " ", 3)
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("unsupported filter %q", part)
+		}
+		op := strings.ToLower(fields[1])
+		switch op {
+		case "eq", "ne", "contains", "gt", "lt", "aftertime", "beforetime":
+		default:
+			return nil, fmt.Errorf("unsupported filter operator %q", fields[1])
+		}
+
+		value := strings.TrimSpace(fields[2])
+		if strings.HasPrefix(value, "'") && strings.HasSuffix(value, "'") && len(value) >= 2 {
+			value = strings.ReplaceAll(value[1:len(value)-1], "''", "'")
+		}
+		conditions = append(conditions, condition{field: fields[0], op: op, value: value})
+	}
+	return conditions, nil
+}
+
+// matchesAll reports whether a record satisfies every condition
+func matchesAll(rec record, conditions []condition) bool {
+	for _, c := range conditions {
+		v, _ := rec.lookup(c.field)
+		switch c.op {
+		case "eq":
+			if !strings.EqualFold(valueString(v), c.value) {
+				return false
+			}
+		case "ne":
+			if strings.EqualFold(valueString(v), c.value) {
+				return false
+			}
+		case "contains":
+			if !strings.Contains(strings.ToLower(valueString(v)), strings.ToLower(c.value)) {
+				return false
+			}
+		case "gt", "aftertime":
+			if v == nil || compareValues(v, c.value) <= 0 {
+				return false
+			}
+		case "lt", "beforetime":
+			if v == nil || compareValues(v, c.value) >= 0 {
+				return false
+			}
+		case "ge":
+			if v == nil || compareValues(v, c.value) < 0 {
+				return false
+			}
+		case "le":
+			if v == nil || compareValues(v, c.value) > 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Helper functions
+
+// superOfficeEntity resolves the tenant and entity of a request, answering 404 for unknown entities
+func superOfficeEntity(w http.ResponseWriter, r *http.Request) (string, string, bool) {
+	entity, ok := matchName(chi.URLParam(r, "entity"), superOfficeNames())
+	if !ok {
+		superOfficeError(w, http.StatusNotFound, "unknown entity "+chi.URLParam(r, "entity"))
+		return "", "", false
+	}
+	return chi.URLParam(r, "tenant"), entity, true
+}
+
+// superOfficeNames returns the served entity names
+func superOfficeNames() []string {
+	names := make([]string, 0, len(superOfficeEntities))
+	for name := range superOfficeEntities {
+		names = append(names, name)
+	}
+	return names
+}
+
+// splitOutsideQuotes splits s at sep (case-insensitively) where sep is not inside a quoted literal
+func splitOutsideQuotes(s, sep string) []string {
+	var parts []string
+	lower := strings.ToLower(s)
+	inQuote := false
+	start := 0
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\'' {
+			inQuote = !inQuote
+			continue
+		}
+		if !inQuote && strings.HasPrefix(lower[i:], sep) {
+			parts = append(parts, s[start:i])
+			start = i + len(sep)
+			i = start - 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// queryInt reads a non-negative integer query parameter
+func queryInt(query url.Values, name string, fallback int) (int, error) {
+	value := query.Get(name)
+	if value == "" {
+		return fallback, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid %s", name)
+	}
+	return n, nil
+}
+
+// superOfficeError writes an error the way the SuperOffice API reports them
+func superOfficeError(w http.ResponseWriter, status int, message string) {
+	respondJSON(w, status, map[string]string{
+		"Error":     message,
+		"ErrorType": http.StatusText(status),
+	})
+}
@@ -0,0 +1,354 @@
+package fakeprovider
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// vismaCompanyHeader selects the company a Visma.net request operates on
+const vismaCompanyHeader = "ipp-company-id"
+
+// vismaEntity describes how a Visma.net entity is identified
+type vismaEntity struct {
+	key   string // Field used in URLs, e.g. the customer number
+	id    string // Numeric internal ID, if the entity has one
+	first int    // First generated key when a create request has none
+}
+
+// vismaEntities are the Visma.net entities served
+var vismaEntities = map[string]vismaEntity{
+	"customer":        {key: "number", id: "internalId", first: 10000},
+	"supplier":        {key: "number", id: "internalId", first: 50000},
+	"customerinvoice": {key: "referenceNumber", first: 100000},
+	"inventory":       {key: "inventoryNumber", id: "inventoryId", first: 1000},
+	"project":         {key: "projectID", id: "internalId", first: 1},
+}
+
+// vismaTime is how Visma.net formats timestamps
+const vismaTime = "2006-01-02T15:04:05.999"
+
+// vismaListParams are list parameters that are not field filters
+var vismaListParams = map[string]bool{
+	"pageNumber":                    true,
+	"pageSize":                      true,
+	"lastModifiedDateTime":          true,
+	"lastModifiedDateTimeCondition": true,
+}
+
+// vismaRoutes registers the Visma.net ERP API
+func (s *Server) vismaRoutes(r chi.Router) {
+	r.Get("/controller/api/v1/{entity}", s.vismaList)
+	r.Post("/controller/api/v1/{entity}", s.vismaCreate)
+	r.Get("/controller/api/v1/{entity}/{key}", s.vismaGet)
+	r.Put("/controller/api/v1/{entity}/{key}", s.vismaUpdate)
+}
+
+// requireCompany rejects Visma.net calls without an accessible company
+func (s *Server) requireCompany(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		company := r.Header.Get(vismaCompanyHeader)
+		if company == "" {
+			vismaError(w, http.StatusBadRequest, "ValidationException", "The "+vismaCompanyHeader+" header is required")
+			return
+		}
+		if len(s.cfg.VismaCompanies) > 0 {
+			if _, ok := matchName(company, s.cfg.VismaCompanies); !ok {
+				vismaError(w, http.StatusForbidden, "ForbiddenException", "No access to company "+company)
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// vismaList serves paged list requests filtered by lastModifiedDateTime and field values
+func (s *Server) vismaList(w http.ResponseWriter, r *http.Request) {
+	entity, ok := vismaEntityName(w, r)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+	pageNumber, err := queryInt(query, "pageNumber", 1)
+	if err != nil || pageNumber < 1 {
+		vismaError(w, http.StatusBadRequest, "ValidationException", "invalid pageNumber")
+		return
+	}
+	pageSize, err := queryInt(query, "pageSize", 100)
+	if err != nil || pageSize < 1 {
+		vismaError(w, http.StatusBadRequest, "ValidationException", "invalid pageSize")
+		return
+	}
+
+	var conditions []condition
+	if modified := query.Get("lastModifiedDateTime"); modified != "" {
+		op := map[string]string{">": "gt", ">=": "ge", "<": "lt", "<=": "le"}[query.Get("lastModifiedDateTimeCondition")]
+		if op == "" {
+			vismaError(w, http.StatusBadRequest, "ValidationException", "lastModifiedDateTimeCondition must be >, >=, < or <=")
+			return
+		}
+		conditions = append(conditions, condition{field: "lastModifiedDateTime", op: op, value: modified})
+	}
+	for key := range query {
+		if !vismaListParams[key] {
+			conditions = append(conditions, condition{field: key, op: "eq", value: query.Get(key)})
+		}
+	}
+
+	s.mu.Lock()
+	var matched []record
+	for _, rec := range s.collection(Visma, r.Header.Get(vismaCompanyHeader), entity).records {
+		if matchesAll(rec, conditions) {
+			matched = append(matched, rec)
+		}
+	}
+	page := []record{}
+	for i := (pageNumber - 1) * pageSize; i < len(matched) && i < pageNumber*pageSize; i++ {
+		page = append(page, matched[i].clone())
+	}
+	s.mu.Unlock()
+
+	respondJSON(w, http.StatusOK, page)
+}
+
+// vismaGet returns one entity by its key
+func (s *Server) vismaGet(w http.ResponseWriter, r *http.Request) {
+	entity, ok := vismaEntityName(w, r)
+	if !ok {
+		return
+	}
+	key := chi.URLParam(r, "key")
+
+	s.mu.Lock()
+	c := s.collection(Visma, r.Header.Get(vismaCompanyHeader), entity)
+	var rec record
+	if i := c.find(vismaEntities[entity].key, key); i >= 0 {
+		rec = c.records[i].clone()
+	}
+	s.mu.Unlock()
+
+	if rec == nil {
+		vismaError(w, http.StatusNotFound, "NotFoundException", fmt.Sprintf("%s %s not found", entity, key))
+		return
+	}
+	respondJSON(w, http.StatusOK, rec)
+}
+
+// vismaCreate stores a new entity and answers with its location only, like Visma.net
+func (s *Server) vismaCreate(w http.ResponseWriter, r *http.Request) {
+	entity, ok := vismaEntityName(w, r)
+	if !ok {
+		return
+	}
+
+	var body record
+	if err := decodeJSON(r, &body); err != nil {
+		vismaError(w, http.StatusBadRequest, "ValidationException", err.Error())
+		return
+	}
+
+	created, err := s.createVisma(r.Header.Get(vismaCompanyHeader), entity, unwrapPayload(body))
+	if err != nil {
+		vismaError(w, http.StatusBadRequest, "ValidationException", err.Error())
+		return
+	}
+
+	key := valueString(created[vismaEntities[entity].key])
+	w.Header().Set("Location", baseURL(r)+"/controller/api/v1/"+entity+"/"+url.PathEscape(key))
+	w.WriteHeader(http.StatusCreated)
+}
+
+// vismaUpdate merges the sent fields into an entity
+func (s *Server) vismaUpdate(w http.ResponseWriter, r *http.Request) {
+	entity, ok := vismaEntityName(w, r)
+	if !ok {
+		return
+	}
+	key := chi.URLParam(r, "key")
+
+	var body record
+	if err := decodeJSON(r, &body); err != nil {
+		vismaError(w, http.StatusBadRequest, "ValidationException", err.Error())
+		return
+	}
+	changes := unwrapPayload(body)
+	normalizeVisma(entity, changes, false)
+
+	s.mu.Lock()
+	c := s.collection(Visma, r.Header.Get(vismaCompanyHeader), entity)
+	def := vismaEntities[entity]
+	i := c.find(def.key, key)
+	if i >= 0 {
+		existing := c.records[i]
+		for field, value := range changes {
+			if field == def.key || field == def.id {
+				continue
+			}
+			existing[field] = value
+		}
+		existing["lastModifiedDateTime"] = s.cfg.Now().UTC().Format(vismaTime)
+	}
+	s.mu.Unlock()
+
+	if i < 0 {
+		vismaError(w, http.StatusNotFound, "NotFoundException", fmt.Sprintf("%s %s not found", entity, key))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// createVisma stores an entity in a company, assigning its key and internal ID when missing
+func (s *Server) createVisma(company, name string, rec record) (record, error) {
+	entity, ok := matchName(name, vismaNames())
+	if !ok {
+		return nil, fmt.Errorf("unknown Visma entity %q", name)
+	}
+	def := vismaEntities[entity]
+	normalizeVisma(entity, rec, true)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c := s.collection(Visma, company, entity)
+	key := ""
+	if v, ok := rec.lookup(def.key); ok {
+		key = valueString(v)
+	}
+	if key == "" {
+		key = strconv.Itoa(def.first + len(c.records))
+		for c.find(def.key, key) >= 0 {
+			n, _ := strconv.Atoi(key)
+			key = strconv.Itoa(n + 1)
+		}
+	} else if c.find(def.key, key) >= 0 {
+		return nil, fmt.Errorf("%s %s already exists", entity, key)
+	}
+	rec[def.key] = key
+
+	if def.id != "" {
+		c.nextID++
+		rec[def.id] = c.nextID
+	}
+	if v, ok := rec.lookup("lastModifiedDateTime"); !ok || v == nil {
+		rec["lastModifiedDateTime"] = s.cfg.Now().UTC().Format(vismaTime)
+	}
+	c.records = append(c.records, rec)
+	return rec.clone(), nil
+}
+
+// normalizeVisma turns write-only fields into the shape Visma.net returns
+// them in and, for new records, fills in the defaults it would set
+func normalizeVisma(entity string, rec record, defaults bool) {
+	for _, field := range []string{"mainAddress", "invoiceAddress", "deliveryAddress"} {
+		if address, ok := rec[field].(map[string]interface{}); ok {
+			if country, ok := address["countryId"]; ok {
+				address["country"] = map[string]interface{}{"id": country}
+				delete(address, "countryId")
+			}
+		}
+	}
+
+	switch entity {
+	case "customer", "supplier", "inventory", "project":
+		if _, ok := rec["status"]; !ok && defaults {
+			rec["status"] = "Active"
+		}
+
+	case "customerinvoice":
+		if number, ok := rec["customerNumber"]; ok {
+			rec["customer"] = map[string]interface{}{"number": number}
+			delete(rec, "customerNumber")
+		}
+		if _, ok := rec["documentType"]; !ok && defaults {
+			rec["documentType"] = "Invoice"
+		}
+		if _, ok := rec["status"]; !ok && defaults {
+			rec["status"] = "Hold"
+		}
+
+		lines, _ := rec["invoiceLines"].([]interface{})
+		total := 0.0
+		for i, l := range lines {
+			line, ok := l.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			delete(line, "operation")
+			quantity, _ := valueNumber(line["quantity"])
+			price, _ := valueNumber(line["unitPriceInCurrency"])
+			line["lineNumber"] = i + 1
+			line["amountInCurrency"] = quantity * price
+			total += quantity * price
+		}
+		if len(lines) > 0 {
+			rec["amount"] = total
+			rec["balance"] = total
+		}
+	}
+}
+
+// Helper functions
+
+// unwrapPayload turns a write payload, where every field is {"value": ...}, into plain fields
+func unwrapPayload(v record) record {
+	out := record{}
+	for key, value := range v {
+		out[key] = unwrapValue(value)
+	}
+	return out
+}
+
+// unwrapValue unwraps one payload value
+func unwrapValue(v interface{}) interface{} {
+	switch typed := v.(type) {
+	case map[string]interface{}:
+		if inner, ok := typed["value"]; ok && len(typed) == 1 {
+			return unwrapValue(inner)
+		}
+		out := make(map[string]interface{}, len(typed))
+		for key, value := range typed {
+			out[key] = unwrapValue(value)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(typed))
+		for i, value := range typed {
+			out[i] = unwrapValue(value)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// vismaEntityName resolves the entity of a request, answering 404 for unknown entities
+func vismaEntityName(w http.ResponseWriter, r *http.Request) (string, bool) {
+	entity, ok := matchName(chi.URLParam(r, "entity"), vismaNames())
+	if !ok {
+		vismaError(w, http.StatusNotFound, "NotFoundException", "unknown endpoint "+chi.URLParam(r, "entity"))
+		return "", false
+	}
+	return entity, true
+}
+
+// vismaNames returns the served entity names
+func vismaNames() []string {
+	names := make([]string, 0, len(vismaEntities))
+	for name := range vismaEntities {
+		names = append(names, name)
+	}
+	return names
+}
+
+// vismaError writes an error the way the Visma.net API reports them
+func vismaError(w http.ResponseWriter, status int, exceptionType, message string) {
+	respondJSON(w, status, map[string]string{
+		"ExceptionType":      exceptionType,
+		"ExceptionMessage":   message,
+		"ExceptionFaultCode": strconv.Itoa(status),
+	})
+}
@@ -0,0 +1,229 @@
+package httpclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+)
+
+// Authenticator injects credentials into outbound requests
+type Authenticator interface {
+	// Apply adds credentials to the request
+	Apply(ctx context.Context, req *http.Request) error
+	// Refresh forces new credentials after the upstream rejected those
+	// applied to rejected. It returns false if they cannot be refreshed.
+	Refresh(ctx context.Context, rejected *http.Request) (bool, error)
+}
+
+// TokenSource provides OAuth2 access tokens (implemented by auth.TokenManager)
+type TokenSource interface {
+	AccessToken(ctx context.Context, service string) (string, error)
+	// ForceRefresh replaces the rejected access token, unless it was replaced already
+	ForceRefresh(ctx context.Context, service, rejected string) (string, error)
+}
+
+// AuthConfig describes how an integration authenticates
+type AuthConfig struct {
+	Type       string // "oauth2", "apikey", "bearer", "basic" or "" for none
+	HeaderName string // Header for API keys (default "X-API-Key")
+	APIKey     string // API key or static bearer token
+	Username   string
+	Password   string
+	Service    string      // Token manager service name for OAuth2 (default ServiceName)
+	Tokens     TokenSource // Token manager for OAuth2
+}
+
+// NewAuthenticator creates an Authenticator from configuration
+func NewAuthenticator(cfg AuthConfig) (Authenticator, error) {
+	switch cfg.Type {
+	case "":
+		return nil, nil
+	case "oauth2":
+		if cfg.Tokens == nil {
+			return nil, fmt.Errorf("oauth2 auth requires a token source")
+		}
+		return &OAuth2Auth{Tokens: cfg.Tokens, Service: cfg.Service}, nil
+	case "apikey":
+		header := cfg.HeaderName
+		if header == "" {
+			header = "X-API-Key"
+		}
+		return &APIKeyAuth{HeaderName: header, Key: cfg.APIKey}, nil
+	case "bearer":
+		return &APIKeyAuth{HeaderName: "Authorization", Key: "Bearer " + cfg.APIKey}, nil
+	case "basic":
+		return &BasicAuth{Username: cfg.Username, Password: cfg.Password}, nil
+	default:
+		return nil, fmt.Errorf("unknown auth type: %s", cfg.Type)
+	}
+}
+
+// OAuth2Auth sends bearer tokens from a token manager
+type OAuth2Auth struct {
+	Tokens  TokenSource
+	Service string
+}
+
+// Apply adds the current access token
+func (a *OAuth2Auth) Apply(ctx context.Context, req *http.Request) error {
+	token, err := a.Tokens.AccessToken(ctx, a.Service)
+	if err != nil {
+		return fmt.Errorf("failed to get access token for %s: %w", a.Service, err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// Refresh forces a token refresh, unless another request already replaced the rejected token
+func (a *OAuth2Auth) Refresh(ctx context.Context, rejected *http.Request) (bool, error) {
+	token := strings.TrimPrefix(rejected.Header.Get("Authorization"), "Bearer ")
+	if _, err := a.Tokens.ForceRefresh(ctx, a.Service, token); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// APIKeyAuth sends a static credential in a header
+type APIKeyAuth struct {
+	HeaderName string
+	Key        string
+}
+
+// Apply adds the API key header
+func (a *APIKeyAuth) Apply(ctx context.Context, req *http.Request) error {
+	req.Header.Set(a.HeaderName, a.Key)
+	return nil
+}
+
+// Refresh is not supported for static keys
+func (a *APIKeyAuth) Refresh(ctx context.Context, rejected *http.Request) (bool, error) {
+	return false, nil
+}
+
+// BasicAuth sends HTTP basic credentials
+type BasicAuth struct {
+	Username string
+	Password string
+}
+
+// Apply adds the basic auth header
+func (a *BasicAuth) Apply(ctx context.Context, req *http.Request) error {
+	credentials := base64.StdEncoding.EncodeToString([]byte(a.Username + ":" + a.Password))
+	req.Header.Set("Authorization", "Basic "+credentials)
+	return nil
+}
+
+// Refresh is not supported for basic auth
+func (a *BasicAuth) Refresh(ctx context.Context, rejected *http.Request) (bool, error) {
+	return false, nil
+}
+
+// AuthError reports that credentials for a request could not be obtained
+type AuthError struct {
+	Err error
+}
+
+// Error implements the error interface
+func (e *AuthError) Error() string {
+	return fmt.Sprintf("outbound authentication failed: %v", e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e *AuthError) Unwrap() error {
+	return e.Err
+}
+
+// AuthStats holds statistics for outbound authentication
+type AuthStats struct {
+	Type            string `json:"type"`
+	Unauthorized    uint64 `json:"unauthorized"`
+	Refreshes       uint64 `json:"refreshes"`
+	RefreshFailures uint64 `json:"refresh_failures"`
+}
+
+// authTransport injects credentials and recovers from a single 401 by
+// refreshing them and replaying the request. It sits below the circuit
+// breaker, so a 401 that is fixed by a refresh never counts as a failure.
+type authTransport struct {
+	next     http.RoundTripper
+	auth     Authenticator
+	authType string
+
+	unauthorized    atomic.Uint64
+	refreshes       atomic.Uint64
+	refreshFailures atomic.Uint64
+}
+
+// newAuthTransport wraps next with authentication
+func newAuthTransport(next http.RoundTripper, auth Authenticator, authType string) *authTransport {
+	return &authTransport{next: next, auth: auth, authType: authType}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	// Keep the body so the request can be replayed after a refresh
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read request body: %w", err)
+		}
+	}
+
+	var sent *http.Request
+	send := func() (*http.Response, error) {
+		authReq := req.Clone(ctx)
+		sent = authReq
+		if body != nil {
+			authReq.Body = io.NopCloser(bytes.NewReader(body))
+		}
+		if err := t.auth.Apply(ctx, authReq); err != nil {
+			return nil, &AuthError{Err: err}
+		}
+		return t.next.RoundTrip(authReq)
+	}
+
+	resp, err := send()
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+	t.unauthorized.Add(1)
+
+	// Force exactly one refresh, then replay
+	refreshed, refreshErr := t.auth.Refresh(ctx, sent)
+	if refreshErr != nil {
+		t.refreshFailures.Add(1)
+	}
+	if !refreshed {
+		return resp, nil
+	}
+	t.refreshes.Add(1)
+
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+	resp.Body.Close()
+
+	return send()
+}
+
+// Stats returns authentication statistics
+func (t *authTransport) Stats() AuthStats {
+	if t == nil {
+		return AuthStats{}
+	}
+
+	return AuthStats{
+		Type:            t.authType,
+		Unauthorized:    t.unauthorized.Load(),
+		Refreshes:       t.refreshes.Load(),
+		RefreshFailures: t.refreshFailures.Load(),
+	}
+}
@@ -0,0 +1,173 @@
+package httpclient
+
+import (
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// rateLimitResetHeaders are provider headers announcing when the quota resets,
+// checked in order when Retry-After is absent
+var rateLimitResetHeaders = []string{
+	"X-RateLimit-Reset",
+	"RateLimit-Reset",
+	"X-Rate-Limit-Reset",
+}
+
+// maxHintSeconds bounds parsed delays so absurd values cannot overflow
+const maxHintSeconds = 7 * 24 * 60 * 60
+
+// epochThreshold separates Unix timestamps from delta seconds in reset headers
+const epochThreshold = 1_000_000_000
+
+// backoff returns how long to wait before the next attempt. Server hints
+// (Retry-After, rate limit reset) win over the computed delay; both are
+// capped by max and jittered so clients do not retry in lockstep.
+func backoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	now := time.Now()
+
+	var state *callState
+	if resp != nil && resp.Request != nil {
+		state = callStateFrom(resp.Request.Context())
+	}
+
+	if hint, ok := serverDelay(resp, now); ok {
+		// Spread clients released by the same hint over up to 10% more
+		wait := hint + jitter(hint/10)
+		if wait > max {
+			wait = max
+		}
+		if state != nil {
+			state.lastWait = wait
+		}
+		return wait
+	}
+
+	// Without a response there is no call state; estimate the previous delay
+	prev := max
+	if attemptNum < 20 {
+		prev = min << uint(attemptNum)
+	}
+	if state != nil && state.lastWait > 0 {
+		prev = state.lastWait
+	}
+
+	wait := decorrelatedJitter(min, max, prev)
+	if state != nil {
+		state.lastWait = wait
+	}
+	return wait
+}
+
+// decorrelatedJitter picks a delay between min and three times the previous
+// delay, capped by max ("decorrelated jitter")
+func decorrelatedJitter(min, max, prev time.Duration) time.Duration {
+	if min <= 0 {
+		min = time.Millisecond
+	}
+	if prev < min {
+		prev = min
+	}
+
+	upper := prev * 3
+	if upper > max || upper < prev { // also guards overflow
+		upper = max
+	}
+	if upper <= min {
+		return upper
+	}
+	return min + jitter(upper-min)
+}
+
+// jitter returns a random duration in [0, d)
+func jitter(d time.Duration) time.Duration {
+	if d <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int64N(int64(d)))
+}
+
+// serverDelay extracts the delay requested by the upstream
+func serverDelay(resp *http.Response, now time.Time) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+
+	throttled := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable
+	if throttled {
+		if delay, ok := parseRetryAfter(resp.Header.Get("Retry-After"), now); ok {
+			return delay, true
+		}
+	}
+
+	// Quota headers count when the upstream throttled us or reports an exhausted quota
+	if throttled || quotaExhausted(resp.Header) {
+		for _, name := range rateLimitResetHeaders {
+			if delay, ok := parseRateLimitReset(resp.Header.Get(name), now); ok {
+				return delay, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// parseRetryAfter parses both Retry-After forms: delta seconds and HTTP-date
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		if seconds < 0 || seconds > int64(maxHintSeconds) {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	// http.ParseTime accepts RFC 1123 and the obsolete RFC 850 and asctime forms
+	at, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	if delay := at.Sub(now); delay > 0 {
+		return delay, true
+	}
+	return 0, true
+}
+
+// parseRateLimitReset parses a reset header given as a Unix timestamp or delta seconds
+func parseRateLimitReset(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+
+	reset, err := strconv.ParseFloat(value, 64)
+	if err != nil || reset < 0 || (reset > maxHintSeconds && reset < epochThreshold) || reset > 1e11 {
+		return 0, false
+	}
+
+	if reset >= epochThreshold {
+		at := time.Unix(0, int64(reset*float64(time.Second)))
+		if delay := at.Sub(now); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+	return time.Duration(reset * float64(time.Second)), true
+}
+
+// quotaExhausted reports whether the upstream says no requests remain
+func quotaExhausted(header http.Header) bool {
+	for _, name := range []string{"X-RateLimit-Remaining", "RateLimit-Remaining", "X-Rate-Limit-Remaining"} {
+		if value := header.Get(name); value != "" {
+			remaining, err := strconv.Atoi(strings.TrimSpace(value))
+			return err == nil && remaining <= 0
+		}
+	}
+	return false
+}
@@ -0,0 +1,221 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+// ErrBulkheadFull is returned (wrapped in a *BulkheadError) when an
+// integration has no free request slots
+var ErrBulkheadFull = errors.New("integration bulkhead saturated")
+
+// BulkheadConfig isolates an integration from the rest of the gateway
+type BulkheadConfig struct {
+	MaxConcurrent   int           // Maximum in-flight requests (0 = unlimited)
+	MaxQueue        int           // Maximum requests waiting for a free slot
+	QueueTimeout    time.Duration // Maximum time to wait for a slot (0 = until context is done)
+	MaxConnsPerHost int           // Dedicated connection pool size (defaults to MaxConcurrent)
+	MaxIdleConns    int           // Idle connections kept in the dedicated pool
+	IdleConnTimeout time.Duration // How long idle connections are kept
+}
+
+// BulkheadError reports that a request was rejected because the integration is saturated
+type BulkheadError struct {
+	Service string
+	Reason  string // "queue_full", "queue_timeout"
+	Active  int64
+	Queued  int64
+}
+
+// Error implements the error interface
+func (e *BulkheadError) Error() string {
+	return fmt.Sprintf("%s: %s (%s, active=%d, queued=%d)", e.Service, ErrBulkheadFull, e.Reason, e.Active, e.Queued)
+}
+
+// Is makes errors.Is(err, ErrBulkheadFull) match
+func (e *BulkheadError) Is(target error) bool {
+	return target == ErrBulkheadFull
+}
+
+// StatusCode returns the HTTP status callers should respond with
+func (e *BulkheadError) StatusCode() int {
+	return http.StatusServiceUnavailable
+}
+
+// IsSaturated reports whether err was caused by a saturated bulkhead
+func IsSaturated(err error) bool {
+	return errors.Is(err, ErrBulkheadFull)
+}
+
+// Bulkhead limits concurrent requests for a single integration
+type Bulkhead struct {
+	service      string
+	slots        chan struct{}
+	maxQueue     int64
+	queueTimeout time.Duration
+
+	active    atomic.Int64
+	queued    atomic.Int64
+	accepted  atomic.Uint64
+	rejected  atomic.Uint64
+	timedOut  atomic.Uint64
+	totalWait atomic.Int64
+}
+
+// BulkheadStats holds statistics for a bulkhead
+type BulkheadStats struct {
+	MaxConcurrent int    `json:"max_concurrent"`
+	MaxQueue      int    `json:"max_queue"`
+	Active        int64  `json:"active"`
+	Queued        int64  `json:"queued"`
+	Accepted      uint64 `json:"accepted"`
+	Rejected      uint64 `json:"rejected"`
+	TimedOut      uint64 `json:"timed_out"`
+	AvgWaitMs     int64  `json:"avg_wait_ms"`
+	Saturated     bool   `json:"saturated"`
+}
+
+// NewBulkhead creates a new bulkhead; it returns nil when MaxConcurrent is not set
+func NewBulkhead(service string, cfg BulkheadConfig) *Bulkhead {
+	if cfg.MaxConcurrent <= 0 {
+		return nil
+	}
+
+	return &Bulkhead{
+		service:      service,
+		slots:        make(chan struct{}, cfg.MaxConcurrent),
+		maxQueue:     int64(cfg.MaxQueue),
+		queueTimeout: cfg.QueueTimeout,
+	}
+}
+
+// Acquire waits for a free slot and returns a function that releases it
+func (b *Bulkhead) Acquire(ctx context.Context) (func(), error) {
+	if b == nil {
+		return func() {}, nil
+	}
+
+	// Fast path: a slot is free
+	select {
+	case b.slots <- struct{}{}:
+		return b.admit(0), nil
+	default:
+	}
+
+	// Join the wait queue if there is room
+	if b.queued.Add(1) > b.maxQueue {
+		b.queued.Add(-1)
+		b.rejected.Add(1)
+		return nil, b.saturated("queue_full")
+	}
+	defer b.queued.Add(-1)
+
+	start := time.Now()
+	var timeout <-chan time.Time
+	if b.queueTimeout > 0 {
+		timer := time.NewTimer(b.queueTimeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	select {
+	case b.slots <- struct{}{}:
+		return b.admit(time.Since(start)), nil
+	case <-timeout:
+		b.timedOut.Add(1)
+		return nil, b.saturated("queue_timeout")
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// admit records an accepted request and builds its release function
+func (b *Bulkhead) admit(wait time.Duration) func() {
+	b.active.Add(1)
+	b.accepted.Add(1)
+	b.totalWait.Add(int64(wait))
+
+	var released atomic.Bool
+	return func() {
+		if released.CompareAndSwap(false, true) {
+			b.active.Add(-1)
+			<-b.slots
+		}
+	}
+}
+
+// saturated builds a BulkheadError for the current state
+func (b *Bulkhead) saturated(reason string) error {
+	return &BulkheadError{
+		Service: b.service,
+		Reason:  reason,
+		Active:  b.active.Load(),
+		Queued:  b.queued.Load(),
+	}
+}
+
+// Stats returns bulkhead statistics
+func (b *Bulkhead) Stats() BulkheadStats {
+	if b == nil {
+		return BulkheadStats{}
+	}
+
+	stats := BulkheadStats{
+		MaxConcurrent: cap(b.slots),
+		MaxQueue:      int(b.maxQueue),
+		Active:        b.active.Load(),
+		Queued:        b.queued.Load(),
+		Accepted:      b.accepted.Load(),
+		Rejected:      b.rejected.Load(),
+		TimedOut:      b.timedOut.Load(),
+	}
+	if stats.Accepted > 0 {
+		stats.AvgWaitMs = time.Duration(b.totalWait.Load() / int64(stats.Accepted)).Milliseconds()
+	}
+	stats.Saturated = stats.Active >= int64(stats.MaxConcurrent) && stats.Queued >= int64(stats.MaxQueue)
+
+	return stats
+}
+
+// newTransport creates a dedicated connection pool for an integration
+func newTransport(cfg BulkheadConfig) *http.Transport {
+	maxConnsPerHost := cfg.MaxConnsPerHost
+	if maxConnsPerHost == 0 {
+		maxConnsPerHost = cfg.MaxConcurrent
+	}
+
+	maxIdleConns := cfg.MaxIdleConns
+	if maxIdleConns == 0 {
+		maxIdleConns = 100
+	}
+
+	idleConnTimeout := cfg.IdleConnTimeout
+	if idleConnTimeout == 0 {
+		idleConnTimeout = 90 * time.Second
+	}
+
+	maxIdleConnsPerHost := maxIdleConns
+	if maxConnsPerHost > 0 && maxConnsPerHost < maxIdleConnsPerHost {
+		maxIdleConnsPerHost = maxConnsPerHost
+	}
+
+	return &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		MaxIdleConns:          maxIdleConns,
+		MaxIdleConnsPerHost:   maxIdleConnsPerHost,
+		MaxConnsPerHost:       maxConnsPerHost,
+		IdleConnTimeout:       idleConnTimeout,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+		ForceAttemptHTTP2:     true,
+	}
+}
@@ -0,0 +1,34 @@
+package httpclient
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+// callState tracks a single Do call across its retry attempts
+type callState struct {
+	attempt    int           // Zero-based attempt number of the request in flight
+	replayable bool          // Request may be retried after the upstream could have processed it
+	lastWait   time.Duration // Previous backoff delay, for decorrelated jitter
+	log        logDecision
+	admitted   atomic.Bool // The rate limit already let the next attempt through
+}
+
+// callStateKey is the context key for callState
+type callStateKey struct{}
+
+// withCallState returns a copy of the request carrying a fresh callState
+func withCallState(req *http.Request) (*http.Request, *callState) {
+	state := &callState{}
+	return req.Clone(context.WithValue(req.Context(), callStateKey{}, state)), state
+}
+
+// callStateFrom retrieves the callState from a context
+func callStateFrom(ctx context.Context) *callState {
+	if state, ok := ctx.Value(callStateKey{}).(*callState); ok {
+		return state
+	}
+	return nil
+}
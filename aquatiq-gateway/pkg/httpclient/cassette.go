@@ -0,0 +1,246 @@
+package httpclient
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// Cassette modes
+const (
+	CassetteOff    = ""
+	CassetteRecord = "record"
+	CassetteReplay = "replay"
+)
+
+// ErrNoInteraction is returned in replay mode when no recorded interaction matches a request
+var ErrNoInteraction = errors.New("no recorded interaction matches request")
+
+// CassetteConfig holds record/replay configuration
+type CassetteConfig struct {
+	Mode          string   // "record", "replay" or "" (off)
+	Path          string   // Cassette file
+	RedactHeaders []string // Headers scrubbed before saving (default DefaultRedactHeaders)
+	RedactFields  []string // Body/query fields scrubbed before saving (default DefaultRedactFields)
+}
+
+// Cassette is a recorded set of HTTP interactions
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is one recorded request and its response
+type Interaction struct {
+	Request    RecordedRequest  `json:"request"`
+	Response   RecordedResponse `json:"response"`
+	RecordedAt time.Time        `json:"recorded_at"`
+}
+
+// RecordedRequest is the scrubbed request of an interaction
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// RecordedResponse is the scrubbed response of an interaction
+type RecordedResponse struct {
+	StatusCode   int         `json:"status_code"`
+	Header       http.Header `json:"header,omitempty"`
+	Body         string      `json:"body,omitempty"`
+	BodyEncoding string      `json:"body_encoding,omitempty"` // "base64" for binary bodies
+}
+
+// cassetteTransport records interactions to, or replays them from, a cassette file
+type cassetteTransport struct {
+	next     http.RoundTripper // Network transport (record mode only)
+	mode     string
+	path     string
+	redactor *Redactor
+
+	mu       sync.Mutex
+	cassette Cassette
+	used     []bool
+}
+
+// newCassetteTransport creates a record or replay transport
+func newCassetteTransport(next http.RoundTripper, cfg CassetteConfig) (*cassetteTransport, error) {
+	if cfg.Path == "" {
+		return nil, fmt.Errorf("cassette path is required in %s mode", cfg.Mode)
+	}
+
+	t := &cassetteTransport{
+		next:     next,
+		mode:     cfg.Mode,
+		path:     cfg.Path,
+		redactor: NewRedactor(cfg.RedactHeaders, cfg.RedactFields),
+	}
+
+	switch cfg.Mode {
+	case CassetteRecord:
+		// Recording starts a fresh cassette
+	case CassetteReplay:
+		data, err := os.ReadFile(cfg.Path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read cassette: %w", err)
+		}
+		if err := json.Unmarshal(data, &t.cassette); err != nil {
+			return nil, fmt.Errorf("invalid cassette %s: %w", cfg.Path, err)
+		}
+		t.used = make([]bool, len(t.cassette.Interactions))
+	default:
+		return nil, fmt.Errorf("unknown cassette mode: %s", cfg.Mode)
+	}
+
+	return t, nil
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *cassetteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read request body: %w", err)
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	recorded := t.recordRequest(req, body)
+
+	if t.mode == CassetteReplay {
+		return t.replay(req, recorded)
+	}
+	return t.record(req, recorded)
+}
+
+// recordRequest builds the scrubbed form of a request used for saving and matching
+func (t *cassetteTransport) recordRequest(req *http.Request, body []byte) RecordedRequest {
+	return RecordedRequest{
+		Method: req.Method,
+		URL:    t.redactor.URL(req.URL),
+		Header: t.redactor.Header(req.Header),
+		Body:   string(t.redactor.Body(req.Header.Get("Content-Type"), body)),
+	}
+}
+
+// replay serves the first unused interaction matching method, URL and body.
+// Identical requests are answered in recorded order.
+func (t *cassetteTransport) replay(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for i, interaction := range t.cassette.Interactions {
+		if t.used[i] || !matches(interaction.Request, recorded) {
+			continue
+		}
+		t.used[i] = true
+
+		body := []byte(interaction.Response.Body)
+		if interaction.Response.BodyEncoding == "base64" {
+			decoded, err := base64.StdEncoding.DecodeString(interaction.Response.Body)
+			if err != nil {
+				return nil, fmt.Errorf("invalid recorded body: %w", err)
+			}
+			body = decoded
+		}
+
+		header := interaction.Response.Header.Clone()
+		if header == nil {
+			header = make(http.Header)
+		}
+		header.Set("Content-Length", strconv.Itoa(len(body)))
+
+		return &http.Response{
+			StatusCode:    interaction.Response.StatusCode,
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s %s", ErrNoInteraction, recorded.Method, recorded.URL)
+}
+
+// matches compares the parts of a request that identify an interaction
+func matches(recorded, req RecordedRequest) bool {
+	return recorded.Method == req.Method && recorded.URL == req.URL && recorded.Body == req.Body
+}
+
+// record sends the request and appends the scrubbed interaction to the cassette
+func (t *cassetteTransport) record(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		// Transport errors are not recorded; replay reports them as missing interactions
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	response := RecordedResponse{
+		StatusCode: resp.StatusCode,
+		Header:     t.redactor.Header(resp.Header),
+	}
+	scrubbed := t.redactor.Body(resp.Header.Get("Content-Type"), body)
+	if utf8.Valid(scrubbed) {
+		response.Body = string(scrubbed)
+	} else {
+		response.Body = base64.StdEncoding.EncodeToString(scrubbed)
+		response.BodyEncoding = "base64"
+	}
+	response.Header.Del("Content-Length")
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.cassette.Interactions = append(t.cassette.Interactions, Interaction{
+		Request:    recorded,
+		Response:   response,
+		RecordedAt: time.Now().UTC(),
+	})
+	if err := t.save(); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// save writes the cassette atomically so an interrupted run never leaves a partial file
+func (t *cassetteTransport) save() error {
+	data, err := json.MarshalIndent(t.cassette, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode cassette: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(t.path), 0o755); err != nil {
+		return fmt.Errorf("failed to create cassette directory: %w", err)
+	}
+	tmp := t.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write cassette: %w", err)
+	}
+	return os.Rename(tmp, t.path)
+}
@@ -0,0 +1,92 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/sony/gobreaker/v2"
+)
+
+// FailedWrite is a write that failed after all retries or was rejected by
+// an open circuit breaker. It holds everything needed to send it again.
+type FailedWrite struct {
+	Service     string
+	Method      string
+	URL         string
+	Header      http.Header // Request headers without credentials
+	Body        []byte
+	Error       string
+	StatusCode  int  // Upstream status, 0 if the provider never answered
+	BreakerOpen bool // Rejected without being sent
+	FailedAt    time.Time
+}
+
+// DeadLetterSink keeps failed writes so they can be replayed (implemented by deadletter.Queue)
+type DeadLetterSink interface {
+	Capture(ctx context.Context, write FailedWrite) error
+}
+
+// noDeadLetterCtxKey is the context key for the dead-letter opt-out
+type noDeadLetterCtxKey struct{}
+
+// WithoutDeadLetter keeps a failed write out of the dead-letter sink, e.g.
+// when the write is itself a replay of a dead letter
+func WithoutDeadLetter(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noDeadLetterCtxKey{}, true)
+}
+
+// deadLetterHeaders are never stored with a failed write
+var deadLetterHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "X-API-Key"}
+
+// deadLetterBody returns a copy of a write's body, or nil for requests that
+// are not captured; the request body stays readable
+func (c *Client) deadLetterBody(req *http.Request) ([]byte, bool) {
+	if c.config.DeadLetters == nil || isSafeMethod(req.Method) {
+		return nil, false
+	}
+	if skip, _ := req.Context().Value(noDeadLetterCtxKey{}).(bool); skip {
+		return nil, false
+	}
+	return copyBody(req)
+}
+
+// captureDeadLetter hands a failed write to the dead-letter sink when the
+// failure was the upstream's; rejected requests would fail again unchanged
+func (c *Client) captureDeadLetter(req *http.Request, body []byte, err error) {
+	breakerOpen := errors.Is(err, gobreaker.ErrOpenState) || errors.Is(err, gobreaker.ErrTooManyRequests)
+	var statusErr *StatusError
+	hasStatus := errors.As(err, &statusErr)
+	switch {
+	case errors.Is(err, context.Canceled):
+		return
+	case hasStatus && !isUpstreamFailure(statusErr.StatusCode):
+		return
+	}
+
+	header := req.Header.Clone()
+	for _, name := range deadLetterHeaders {
+		header.Del(name)
+	}
+	write := FailedWrite{
+		Service:     c.config.ServiceName,
+		Method:      req.Method,
+		URL:         req.URL.String(),
+		Header:      header,
+		Body:        body,
+		Error:       err.Error(),
+		BreakerOpen: breakerOpen,
+		FailedAt:    time.Now(),
+	}
+	if hasStatus {
+		write.StatusCode = statusErr.StatusCode
+	}
+
+	// The caller's context may already be done, but the write must still be kept
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(req.Context()), 10*time.Second)
+	defer cancel()
+	if captureErr := c.config.DeadLetters.Capture(ctx, write); captureErr != nil && c.audit != nil {
+		c.audit.LogIntegrationCall(c.config.ServiceName, "dead_letter_capture "+req.Method+" "+req.URL.Path, false, captureErr, 0)
+	}
+}
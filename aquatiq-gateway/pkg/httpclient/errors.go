@@ -0,0 +1,36 @@
+package httpclient
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// maxResponseBody is the largest response body read into memory
+const maxResponseBody = 32 << 20 // 32MB
+
+// StatusError is returned for non-2xx upstream responses
+type StatusError struct {
+	Service    string
+	StatusCode int
+	Status     string
+	Header     http.Header
+	Body       []byte
+}
+
+// Error implements the error interface
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%s returned HTTP %s", e.Service, e.Status)
+}
+
+// IsStatus reports whether err is a StatusError with the given status code
+func IsStatus(err error, code int) bool {
+	var statusErr *StatusError
+	return errors.As(err, &statusErr) && statusErr.StatusCode == code
+}
+
+// isUpstreamFailure reports whether a status means the upstream is unhealthy
+// (as opposed to rejecting a bad request) and should trip the circuit breaker
+func isUpstreamFailure(code int) bool {
+	return code >= 500 || code == http.StatusTooManyRequests
+}
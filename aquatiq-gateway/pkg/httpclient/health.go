@@ -0,0 +1,117 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Recent outcomes are counted in buckets, so the error rate covers the last
+// healthWindow without storing every call
+const (
+	healthWindow  = 5 * time.Minute
+	healthBuckets = 30
+)
+
+// healthBucket counts the outcomes of one slice of the window
+type healthBucket struct {
+	start    time.Time
+	calls    uint64
+	failures uint64
+}
+
+// callHealth tracks the outcome of recent calls to an integration
+type callHealth struct {
+	mu          sync.Mutex
+	buckets     [healthBuckets]healthBucket
+	lastSuccess time.Time
+	lastFailure time.Time
+	lastError   string
+}
+
+// CallStats holds the outcome of recent calls
+type CallStats struct {
+	WindowSeconds float64   `json:"window_seconds"`
+	Calls         uint64    `json:"calls"`
+	Failures      uint64    `json:"failures"`
+	ErrorRate     float64   `json:"error_rate"` // Failures / calls within the window
+	LastSuccess   time.Time `json:"last_success,omitempty"`
+	LastFailure   time.Time `json:"last_failure,omitempty"`
+	LastError     string    `json:"last_error,omitempty"`
+}
+
+// record counts the outcome of a call that reached the circuit breaker;
+// calls the caller cancelled say nothing about the integration
+func (h *callHealth) record(err error) {
+	if errors.Is(err, context.Canceled) {
+		return
+	}
+	now := time.Now()
+	failed := isHealthFailure(err)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	b := h.bucket(now)
+	b.calls++
+	if failed {
+		b.failures++
+		h.lastFailure = now
+		h.lastError = err.Error()
+	} else if err == nil {
+		h.lastSuccess = now
+	}
+}
+
+// Stats returns the outcome of calls within the window
+func (h *callHealth) Stats() CallStats {
+	now := time.Now()
+	stats := CallStats{WindowSeconds: healthWindow.Seconds()}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, b := range h.buckets {
+		if now.Sub(b.start) < healthWindow {
+			stats.Calls += b.calls
+			stats.Failures += b.failures
+		}
+	}
+	if stats.Calls > 0 {
+		stats.ErrorRate = float64(stats.Failures) / float64(stats.Calls)
+	}
+	stats.LastSuccess = h.lastSuccess
+	stats.LastFailure = h.lastFailure
+	stats.LastError = h.lastError
+	return stats
+}
+
+// Helper functions
+
+// bucket returns the bucket for a time, clearing it when it is from an earlier round; the caller holds the lock
+func (h *callHealth) bucket(now time.Time) *healthBucket {
+	width := healthWindow / healthBuckets
+	start := now.Truncate(width)
+	b := &h.buckets[(start.UnixNano()/int64(width))%healthBuckets]
+	if !b.start.Equal(start) {
+		*b = healthBucket{start: start}
+	}
+	return b
+}
+
+// isHealthFailure reports whether a call outcome says the integration is unhealthy.
+// Client errors other than rejected credentials are the caller's problem.
+func isHealthFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return isUpstreamFailure(statusErr.StatusCode) ||
+			statusErr.StatusCode == http.StatusUnauthorized ||
+			statusErr.StatusCode == http.StatusForbidden
+	}
+	return true
+}
@@ -0,0 +1,246 @@
+package httpclient
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// HedgeConfig controls hedged requests for idempotent calls
+type HedgeConfig struct {
+	Enabled    bool
+	Percentile float64       // Latency percentile after which a hedge is sent (default 0.95)
+	MinDelay   time.Duration // Lower bound on the hedge delay (default 10ms)
+	MinSamples int           // Latency samples needed before hedging starts (default 20)
+	WindowSize int           // Number of recent latencies tracked (default 200)
+}
+
+// HedgeStats holds statistics for hedged requests
+type HedgeStats struct {
+	Enabled   bool   `json:"enabled"`
+	DelayMs   int64  `json:"delay_ms"`
+	Hedged    uint64 `json:"hedged"`
+	HedgeWins uint64 `json:"hedge_wins"`
+	Skipped   uint64 `json:"skipped_no_budget"`
+}
+
+// latencyTracker keeps a sliding window of recent request latencies
+type latencyTracker struct {
+	mu      sync.Mutex
+	samples []time.Duration
+	next    int
+	full    bool
+}
+
+// newLatencyTracker creates a tracker holding size samples
+func newLatencyTracker(size int) *latencyTracker {
+	return &latencyTracker{samples: make([]time.Duration, size)}
+}
+
+// Record adds a latency sample
+func (t *latencyTracker) Record(d time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.samples[t.next] = d
+	t.next = (t.next + 1) % len(t.samples)
+	if t.next == 0 {
+		t.full = true
+	}
+}
+
+// Percentile returns the latency at percentile p and the number of samples it is based on
+func (t *latencyTracker) Percentile(p float64) (time.Duration, int) {
+	t.mu.Lock()
+	n := t.next
+	if t.full {
+		n = len(t.samples)
+	}
+	sorted := slices.Clone(t.samples[:n])
+	t.mu.Unlock()
+
+	if n == 0 {
+		return 0, 0
+	}
+
+	slices.Sort(sorted)
+	idx := int(float64(n-1) * p)
+	return sorted[idx], n
+}
+
+// hedgingTransport sends a second attempt for slow idempotent requests
+// and returns whichever response arrives first
+type hedgingTransport struct {
+	next      http.RoundTripper
+	config    HedgeConfig
+	budget    *RetryBudget
+	latencies *latencyTracker
+
+	hedged    atomic.Uint64
+	hedgeWins atomic.Uint64
+	skipped   atomic.Uint64
+}
+
+// newHedgingTransport wraps next with request hedging
+func newHedgingTransport(next http.RoundTripper, cfg HedgeConfig, budget *RetryBudget) *hedgingTransport {
+	if cfg.Percentile <= 0 || cfg.Percentile >= 1 {
+		cfg.Percentile = 0.95
+	}
+	if cfg.MinDelay == 0 {
+		cfg.MinDelay = 10 * time.Millisecond
+	}
+	if cfg.MinSamples == 0 {
+		cfg.MinSamples = 20
+	}
+	if cfg.WindowSize == 0 {
+		cfg.WindowSize = 200
+	}
+
+	return &hedgingTransport{
+		next:      next,
+		config:    cfg,
+		budget:    budget,
+		latencies: newLatencyTracker(cfg.WindowSize),
+	}
+}
+
+// hedgeResult is the outcome of one attempt
+type hedgeResult struct {
+	resp   *http.Response
+	err    error
+	cancel context.CancelFunc
+	index  int
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *hedgingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	delay, ok := t.delay()
+	if !ok || !isHedgeable(req) {
+		return t.timed(req)
+	}
+
+	results := make(chan hedgeResult, 2)
+	var cancels []context.CancelFunc
+	launch := func() {
+		ctx, cancel := context.WithCancel(req.Context())
+		index := len(cancels)
+		cancels = append(cancels, cancel)
+		go func() {
+			resp, err := t.timed(req.Clone(ctx))
+			results <- hedgeResult{resp: resp, err: err, cancel: cancel, index: index}
+		}()
+	}
+
+	launch()
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	pending := 1
+	var firstErr error
+	for pending > 0 {
+		select {
+		case <-timer.C:
+			// Hedges spend retry tokens so they cannot amplify an outage
+			if !t.budget.Withdraw() {
+				t.skipped.Add(1)
+				continue
+			}
+			t.hedged.Add(1)
+			pending++
+			launch()
+		case res := <-results:
+			pending--
+			if res.err == nil {
+				if res.index > 0 {
+					t.hedgeWins.Add(1)
+				}
+				// Cancel the loser and release it in the background
+				for i, cancel := range cancels {
+					if i != res.index {
+						cancel()
+					}
+				}
+				go drainHedges(results, pending)
+				res.resp.Body = &cancelOnClose{ReadCloser: res.resp.Body, cancel: res.cancel}
+				return res.resp, nil
+			}
+			res.cancel()
+			if firstErr == nil {
+				firstErr = res.err
+			}
+		}
+	}
+
+	return nil, firstErr
+}
+
+// timed executes a single attempt and records its latency
+func (t *hedgingTransport) timed(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	if err == nil {
+		t.latencies.Record(time.Since(start))
+	}
+	return resp, err
+}
+
+// delay returns the current hedge delay, or false if there is not enough data yet
+func (t *hedgingTransport) delay() (time.Duration, bool) {
+	p, n := t.latencies.Percentile(t.config.Percentile)
+	if n < t.config.MinSamples {
+		return 0, false
+	}
+	return max(p, t.config.MinDelay), true
+}
+
+// Stats returns hedging statistics
+func (t *hedgingTransport) Stats() HedgeStats {
+	if t == nil {
+		return HedgeStats{}
+	}
+
+	delay, _ := t.delay()
+	return HedgeStats{
+		Enabled:   true,
+		DelayMs:   delay.Milliseconds(),
+		Hedged:    t.hedged.Load(),
+		HedgeWins: t.hedgeWins.Load(),
+		Skipped:   t.skipped.Load(),
+	}
+}
+
+// isHedgeable reports whether a request may safely be sent twice
+func isHedgeable(req *http.Request) bool {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		return false
+	}
+	return req.Body == nil || req.Body == http.NoBody
+}
+
+// drainHedges cancels and closes the attempts that lost the race
+func drainHedges(results <-chan hedgeResult, pending int) {
+	for ; pending > 0; pending-- {
+		res := <-results
+		res.cancel()
+		if res.resp != nil {
+			res.resp.Body.Close()
+		}
+	}
+}
+
+// cancelOnClose releases the winning attempt's context once its body is closed
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// Close closes the body and cancels the attempt's context
+func (c *cancelOnClose) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+	return err
+}
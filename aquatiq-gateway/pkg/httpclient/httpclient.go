@@ -1,8 +1,12 @@
 package httpclient
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"net"
 	"net/http"
 	"time"
 
@@ -20,14 +24,32 @@ type Config struct {
 	ServiceName    string
 	CircuitBreaker *circuitbreaker.CircuitBreaker
 	AuditLogger    *audit.AuditLogger
+	Bulkhead       BulkheadConfig
+	RateLimit      RateLimitConfig
+	RetryBudget    RetryBudgetConfig
+	Hedge          HedgeConfig
+	Idempotency    IdempotencyConfig
+	Auth           AuthConfig
+	Authenticator  Authenticator    // Custom credential hook; overrides Auth
+	Logging        *LogSettings     // Outbound request/response logging (shared, runtime-switchable)
+	Cassette       CassetteConfig   // Record/replay interactions for offline testing
+	DeadLetters    DeadLetterSink   // Keeps writes that fail after all retries; optional
+	Validator      PayloadValidator // Checks request and response bodies against schemas; optional
 }
 
 // Client wraps retryablehttp with circuit breaker
 type Client struct {
-	client *retryablehttp.Client
-	cb     *circuitbreaker.CircuitBreaker
-	audit  *audit.AuditLogger
-	config Config
+	client    *retryablehttp.Client
+	cb        *circuitbreaker.CircuitBreaker
+	bulkhead  *Bulkhead
+	limiter   *RateLimiter
+	budget    *RetryBudget
+	hedger    *hedgingTransport
+	auth      *authTransport
+	health    *callHealth
+	configErr error
+	audit     *audit.AuditLogger
+	config    Config
 }
 
 // New creates a new HTTP client with retries and circuit breaker
@@ -40,100 +62,290 @@ func New(config Config) *Client {
 	retryClient.RetryWaitMax = config.RetryWaitMax
 	retryClient.HTTPClient.Timeout = config.Timeout
 
-	// Custom retry policy: retry on 5xx, 429, timeouts
-	retryClient.CheckRetry = func(ctx context.Context, resp *http.Response, err error) (bool, error) {
-		// Don't retry on context cancellation
-		if ctx.Err() != nil {
-			return false, ctx.Err()
-		}
+	// Dedicated connection pool so one integration cannot starve the others
+	var transport http.RoundTripper = newTransport(config.Bulkhead)
 
-		// Retry on connection errors
+	// Record real interactions or serve them from a cassette without network access
+	var configErr error
+	if config.Cassette.Mode != CassetteOff {
+		cassette, err := newCassetteTransport(transport, config.Cassette)
 		if err != nil {
-			return true, nil
+			configErr = err
+		} else {
+			transport = cassette
 		}
+	}
+	replaying := config.Cassette.Mode == CassetteReplay
 
-		// Retry on server errors (5xx)
-		if resp.StatusCode >= 500 {
-			return true, nil
-		}
+	// Log each attempt on the wire, after credentials are applied and redacted
+	if config.Logging != nil && config.AuditLogger != nil {
+		transport = newLoggingTransport(transport, config.ServiceName, config.Logging, config.AuditLogger.GetLogger(), func() string {
+			if config.CircuitBreaker == nil {
+				return "none"
+			}
+			return circuitbreaker.StateString(config.CircuitBreaker.State())
+		})
+	}
 
-		// Retry on rate limiting (429)
-		if resp.StatusCode == http.StatusTooManyRequests {
-			return true, nil
-		}
+	// Every attempt, not just every call, waits for the provider quota
+	limiter := NewRateLimiter(config.ServiceName, config.RateLimit)
+	transport = newRateLimitTransport(transport, limiter)
 
-		// Retry on specific client errors
-		if resp.StatusCode == http.StatusRequestTimeout {
-			return true, nil
+	// Retries and hedges share one budget per integration
+	budget := NewRetryBudget(config.RetryBudget)
+
+	// Hedge slow idempotent requests if enabled
+	var hedger *hedgingTransport
+	if config.Hedge.Enabled && !replaying {
+		hedger = newHedgingTransport(transport, config.Hedge, budget)
+		transport = hedger
+	}
+
+	// Inject credentials per request and recover from expired tokens
+	// (replayed cassettes are scrubbed, so no credentials are needed)
+	authenticator := config.Authenticator
+	if authenticator == nil {
+		if config.Auth.Service == "" {
+			config.Auth.Service = config.ServiceName
+		}
+		var authErr error
+		authenticator, authErr = NewAuthenticator(config.Auth)
+		if authErr != nil && configErr == nil {
+			configErr = authErr
 		}
+	}
 
-		return false, nil
+	var authT *authTransport
+	if authenticator != nil && !replaying {
+		authT = newAuthTransport(transport, authenticator, config.Auth.Type)
+		transport = authT
 	}
 
-	// Custom backoff with Retry-After header support
-	retryClient.Backoff = func(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
-		// Check for Retry-After header
-		if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
-			if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
-				if duration, err := time.ParseDuration(retryAfter + "s"); err == nil {
-					return duration
-				}
-			}
+	retryClient.HTTPClient.Transport = transport
+
+	// Track the attempt number of each call
+	retryClient.RequestLogHook = func(_ retryablehttp.Logger, req *http.Request, attemptNum int) {
+		if state := callStateFrom(req.Context()); state != nil {
+			state.attempt = attemptNum
+		}
+	}
+
+	// Custom retry policy: retry on 5xx, 429, timeouts within the retry budget
+	retryClient.CheckRetry = func(ctx context.Context, resp *http.Response, err error) (bool, error) {
+		retry, checkErr := shouldRetry(ctx, resp, err)
+		if !retry || checkErr != nil {
+			return retry, checkErr
+		}
+
+		// POST/PATCH may only be retried if the upstream cannot have processed them,
+		// unless the provider deduplicates by idempotency key or the caller opted in
+		if state := callStateFrom(ctx); state != nil && !state.replayable && !notProcessed(resp, err) {
+			return false, nil
 		}
 
-		// Exponential backoff with jitter
-		wait := min * time.Duration(1<<uint(attemptNum))
-		if wait > max {
-			wait = max
+		// The last attempt will not be retried, so it does not spend budget
+		if state := callStateFrom(ctx); state != nil && state.attempt >= config.RetryMax {
+			return true, nil
 		}
-		return wait
+
+		return budget.Withdraw(), nil
 	}
 
+	// Backoff honours Retry-After and rate limit reset headers, with decorrelated jitter
+	retryClient.Backoff = backoff
+
+	// Return the last response when retries run out, so callers see the upstream error
+	retryClient.ErrorHandler = retryablehttp.PassthroughErrorHandler
+
 	// Disable default logging (we use our own)
 	retryClient.Logger = nil
 
 	return &Client{
-		client: retryClient,
-		cb:     config.CircuitBreaker,
-		audit:  config.AuditLogger,
-		config: config,
+		client:    retryClient,
+		cb:        config.CircuitBreaker,
+		bulkhead:  NewBulkhead(config.ServiceName, config.Bulkhead),
+		limiter:   limiter,
+		budget:    budget,
+		hedger:    hedger,
+		auth:      authT,
+		health:    &callHealth{},
+		configErr: configErr,
+		audit:     config.AuditLogger,
+		config:    config,
 	}
 }
 
+// shouldRetry is the retry policy: retry on 5xx, 429, timeouts
+func shouldRetry(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	// Don't retry on context cancellation
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+
+	// Don't retry when credentials could not be obtained
+	var authErr *AuthError
+	if errors.As(err, &authErr) {
+		return false, nil
+	}
+
+	// An attempt rejected by the outbound rate limit already waited as long as allowed
+	if IsRateLimited(err) {
+		return false, nil
+	}
+
+	// A missing cassette interaction will not appear on retry
+	if errors.Is(err, ErrNoInteraction) {
+		return false, nil
+	}
+
+	// Retry on connection errors
+	if err != nil {
+		return true, nil
+	}
+
+	// Retry on server errors (5xx)
+	if resp.StatusCode >= 500 {
+		return true, nil
+	}
+
+	// Retry on rate limiting (429)
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true, nil
+	}
+
+	// Retry on specific client errors
+	if resp.StatusCode == http.StatusRequestTimeout {
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// notProcessed reports whether a failed attempt certainly did not reach the upstream handler
+func notProcessed(resp *http.Response, err error) bool {
+	if err != nil {
+		var opErr *net.OpError
+		return errors.As(err, &opErr) && opErr.Op == "dial"
+	}
+	return resp.StatusCode == http.StatusTooManyRequests
+}
+
 // Do executes an HTTP request with retries and circuit breaker
 func (c *Client) Do(req *http.Request) (*http.Response, error) {
 	startTime := time.Now()
 
-	// Wrap the request in circuit breaker
-	_, err := c.cb.ExecuteContext(req.Context(), func() ([]byte, error) {
+	if c.configErr != nil {
+		return nil, fmt.Errorf("invalid client configuration for %s: %w", c.config.ServiceName, c.configErr)
+	}
+
+	// Wait for a bulkhead slot; a full bulkhead is not an upstream failure,
+	// so it is rejected before the circuit breaker sees it
+	release, err := c.bulkhead.Acquire(req.Context())
+	if err != nil {
+		if c.audit != nil {
+			c.audit.LogIntegrationCall(
+				c.config.ServiceName,
+				fmt.Sprintf("%s %s", req.Method, req.URL.Path),
+				false,
+				err,
+				time.Since(startTime),
+			)
+		}
+		return nil, err
+	}
+	defer release()
+
+	// Writes whose payload breaks its schema are not sent when the validator rejects them
+	if err := c.validateRequest(req); err != nil {
+		if c.audit != nil {
+			c.audit.LogIntegrationCall(c.config.ServiceName, fmt.Sprintf("%s %s", req.Method, req.URL.Path), false, err, time.Since(startTime))
+		}
+		return nil, err
+	}
+
+	// Keep a copy of write payloads in case they end up as dead letters
+	deadLetterBody, deadLetter := c.deadLetterBody(req)
+
+	// Every first attempt earns retry budget
+	req, state := withCallState(req)
+	state.replayable = isIdempotentMethod(req.Method) || c.canRetryUnsafe(req.Context())
+	state.log = c.config.Logging.decide(c.config.ServiceName)
+	c.budget.Deposit()
+
+	// Unsafe requests carry one idempotency key across all retries
+	c.applyIdempotencyKey(req)
+
+	// The request ID correlates the call with the gateway request it serves
+	applyRequestID(req)
+
+	// The first attempt waits for the rate limit and quotas before the circuit
+	// breaker, so local throttling counts neither as upstream success nor failure
+	if err := c.limiter.Wait(req.Context()); err != nil {
+		if c.audit != nil {
+			c.audit.LogIntegrationCall(c.config.ServiceName, fmt.Sprintf("%s %s", req.Method, req.URL.Path), false, err, time.Since(startTime))
+		}
+		return nil, err
+	}
+	state.admitted.Store(c.limiter != nil)
+
+	// Wrap the request in circuit breaker; only upstream failures (transport
+	// errors, 5xx, 429) count against it, 4xx responses are the caller's problem.
+	// A later attempt the rate limit rejects follows an upstream failure, so it
+	// fails the call.
+	var resp *http.Response
+	var statusErr *StatusError
+	body, err := c.cb.ExecuteContext(req.Context(), func() ([]byte, error) {
 		// Convert to retryable request
 		retryReq, err := retryablehttp.FromRequest(req)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create retryable request: %w", err)
 		}
 
-		// Execute request with retries
-		resp, err := c.client.Do(retryReq)
+		// Execute request with retries; each further attempt waits for the rate limit
+		r, err := c.client.Do(retryReq)
 		if err != nil {
 			return nil, err
 		}
 
-		// Check for non-2xx status codes
-		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-			return nil, fmt.Errorf("HTTP error: %d %s", resp.StatusCode, resp.Status)
-		}
-
 		// Read response body
-		defer resp.Body.Close()
-		body := make([]byte, resp.ContentLength)
-		if _, err := resp.Body.Read(body); err != nil {
+		defer r.Body.Close()
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxResponseBody))
+		if err != nil {
 			return nil, fmt.Errorf("failed to read response: %w", err)
 		}
+		resp = r
+
+		// Check for non-2xx status codes
+		if r.StatusCode < 200 || r.StatusCode >= 300 {
+			statusErr = &StatusError{
+				Service:    c.config.ServiceName,
+				StatusCode: r.StatusCode,
+				Status:     r.Status,
+				Header:     r.Header,
+				Body:       body,
+			}
+			if isUpstreamFailure(r.StatusCode) {
+				return nil, statusErr
+			}
+		}
 
 		return body, nil
 	})
+	if err == nil && statusErr != nil {
+		err = statusErr
+	}
 
 	duration := time.Since(startTime)
+	c.health.record(err)
+	if err != nil && deadLetter {
+		c.captureDeadLetter(req, deadLetterBody, err)
+	}
+
+	// A response that breaks its schema was still delivered, so it counts
+	// neither against the provider's health nor as a dead letter
+	if err == nil {
+		err = c.validateResponse(req, resp, body)
+	}
 
 	// Audit log the request
 	if c.audit != nil {
@@ -150,13 +362,10 @@ func (c *Client) Do(req *http.Request) (*http.Response, error) {
 		return nil, err
 	}
 
-	// Return a mock response (in real implementation, we'd need to reconstruct the response)
-	// For now, this is a simplified version
-	return &http.Response{
-		StatusCode: http.StatusOK,
-		Status:     "200 OK",
-		Body:       http.NoBody,
-	}, nil
+	// The body was read inside the breaker; hand the caller a fresh reader
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	return resp, nil
 }
 
 // Get performs a GET request
@@ -170,7 +379,7 @@ func (c *Client) Get(ctx context.Context, url string) (*http.Response, error) {
 
 // Post performs a POST request
 func (c *Client) Post(ctx context.Context, url string, body []byte, contentType string) (*http.Response, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create POST request: %w", err)
 	}
@@ -180,7 +389,7 @@ func (c *Client) Post(ctx context.Context, url string, body []byte, contentType
 
 // Put performs a PUT request
 func (c *Client) Put(ctx context.Context, url string, body []byte, contentType string) (*http.Response, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, bytes.NewReader(body))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create PUT request: %w", err)
 	}
@@ -197,6 +406,40 @@ func (c *Client) Delete(ctx context.Context, url string) (*http.Response, error)
 	return c.Do(req)
 }
 
+// Stats holds statistics for an integration client
+type Stats struct {
+	Service     string           `json:"service"`
+	Bulkhead    BulkheadStats    `json:"bulkhead"`
+	RateLimit   RateLimitStats   `json:"rate_limit"`
+	RetryBudget RetryBudgetStats `json:"retry_budget"`
+	Hedging     HedgeStats       `json:"hedging"`
+	Auth        AuthStats        `json:"auth"`
+	Breaker     string           `json:"circuit_breaker"`
+	Calls       CallStats        `json:"calls"`
+}
+
+// Stats returns client statistics
+func (c *Client) Stats() Stats {
+	return Stats{
+		Service:     c.config.ServiceName,
+		Bulkhead:    c.bulkhead.Stats(),
+		RateLimit:   c.limiter.Stats(),
+		RetryBudget: c.budget.Stats(),
+		Hedging:     c.hedger.Stats(),
+		Auth:        c.auth.Stats(),
+		Breaker:     c.BreakerState(),
+		Calls:       c.health.Stats(),
+	}
+}
+
+// BreakerState returns the state of the client's circuit breaker
+func (c *Client) BreakerState() string {
+	if c.cb == nil {
+		return "none"
+	}
+	return circuitbreaker.StateString(c.cb.State())
+}
+
 // StandardClient returns the underlying standard HTTP client
 func (c *Client) StandardClient() *http.Client {
 	return c.client.StandardClient()
@@ -0,0 +1,99 @@
+package httpclient
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+)
+
+// DefaultIdempotencyHeader is the header used to send idempotency keys
+const DefaultIdempotencyHeader = "Idempotency-Key"
+
+// IdempotencyConfig controls how non-idempotent requests are retried
+type IdempotencyConfig struct {
+	HeaderName        string // Header carrying the key (default "Idempotency-Key")
+	ProviderSupported bool   // Provider deduplicates by key, so POST/PATCH may be retried
+}
+
+// idempotencyKeyCtxKey is the context key for a caller-supplied idempotency key
+type idempotencyKeyCtxKey struct{}
+
+// retryUnsafeCtxKey is the context key for the retry opt-in
+type retryUnsafeCtxKey struct{}
+
+// WithIdempotencyKey sets the idempotency key sent with the request
+// (useful to keep the same key when the caller itself retries)
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyCtxKey{}, key)
+}
+
+// WithRetryUnsafe allows retrying a POST or PATCH even if the provider
+// does not support idempotency keys
+func WithRetryUnsafe(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retryUnsafeCtxKey{}, true)
+}
+
+// isIdempotentMethod reports whether repeating a request has no additional effect
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace,
+		http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// isSafeMethod reports whether a request does not modify state
+func isSafeMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace:
+		return true
+	default:
+		return false
+	}
+}
+
+// IdempotencyHeader returns the header the client sends idempotency keys in
+func (c *Client) IdempotencyHeader() string {
+	if c.config.Idempotency.HeaderName == "" {
+		return DefaultIdempotencyHeader
+	}
+	return c.config.Idempotency.HeaderName
+}
+
+// applyIdempotencyKey attaches an idempotency key to unsafe requests; the
+// same key is reused for every retry of the call
+func (c *Client) applyIdempotencyKey(req *http.Request) {
+	if isSafeMethod(req.Method) {
+		return
+	}
+
+	header := c.IdempotencyHeader()
+	if req.Header.Get(header) != "" {
+		return
+	}
+
+	key, ok := req.Context().Value(idempotencyKeyCtxKey{}).(string)
+	if !ok || key == "" {
+		key = newIdempotencyKey()
+	}
+	req.Header.Set(header, key)
+}
+
+// canRetryUnsafe reports whether a non-idempotent request may be retried
+func (c *Client) canRetryUnsafe(ctx context.Context) bool {
+	if c.config.Idempotency.ProviderSupported {
+		return true
+	}
+	optIn, _ := ctx.Value(retryUnsafeCtxKey{}).(bool)
+	return optIn
+}
+
+// newIdempotencyKey generates a random idempotency key
+func newIdempotencyKey() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,242 @@
+package httpclient
+
+import (
+	"bytes"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// LogConfig holds outbound request/response logging configuration
+type LogConfig struct {
+	Enabled       bool
+	SampleRate    float64  // Fraction of successful calls logged (failures are always logged)
+	LogBodies     bool     // Include truncated request and response bodies
+	MaxBodyBytes  int      // Body bytes kept per message (default 2048)
+	RedactHeaders []string // Headers to redact (default DefaultRedactHeaders)
+	RedactFields  []string // JSON/form/query fields to redact (default DefaultRedactFields)
+	Debug         []string // Integrations that log every call with bodies
+}
+
+// LogSettings holds outbound logging settings shared by all integration
+// clients. Sampling and debug toggles can be changed at runtime.
+type LogSettings struct {
+	mu           sync.RWMutex
+	enabled      bool
+	sampleRate   float64
+	logBodies    bool
+	maxBodyBytes int
+	debug        map[string]bool
+	redactor     *Redactor
+}
+
+// LogSettingsSnapshot is a point-in-time copy of the logging settings
+type LogSettingsSnapshot struct {
+	Enabled      bool            `json:"enabled"`
+	SampleRate   float64         `json:"sample_rate"`
+	LogBodies    bool            `json:"log_bodies"`
+	MaxBodyBytes int             `json:"max_body_bytes"`
+	Debug        map[string]bool `json:"debug"`
+}
+
+// NewLogSettings creates logging settings from configuration
+func NewLogSettings(cfg LogConfig) *LogSettings {
+	if cfg.MaxBodyBytes == 0 {
+		cfg.MaxBodyBytes = 2048
+	}
+
+	s := &LogSettings{
+		enabled:      cfg.Enabled,
+		sampleRate:   cfg.SampleRate,
+		logBodies:    cfg.LogBodies,
+		maxBodyBytes: cfg.MaxBodyBytes,
+		debug:        make(map[string]bool),
+		redactor:     NewRedactor(cfg.RedactHeaders, cfg.RedactFields),
+	}
+	for _, service := range cfg.Debug {
+		s.debug[service] = true
+	}
+	return s
+}
+
+// SetEnabled turns outbound logging on or off
+func (s *LogSettings) SetEnabled(enabled bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.enabled = enabled
+}
+
+// SetSampleRate changes the fraction of successful calls that are logged
+func (s *LogSettings) SetSampleRate(rate float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sampleRate = min(max(rate, 0), 1)
+}
+
+// SetDebug toggles full logging for one integration
+func (s *LogSettings) SetDebug(service string, debug bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if debug {
+		s.debug[service] = true
+	} else {
+		delete(s.debug, service)
+	}
+}
+
+// Snapshot returns the current settings
+func (s *LogSettings) Snapshot() LogSettingsSnapshot {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	debug := make(map[string]bool, len(s.debug))
+	for service, on := range s.debug {
+		debug[service] = on
+	}
+	return LogSettingsSnapshot{
+		Enabled:      s.enabled,
+		SampleRate:   s.sampleRate,
+		LogBodies:    s.logBodies,
+		MaxBodyBytes: s.maxBodyBytes,
+		Debug:        debug,
+	}
+}
+
+// Redactor returns the redactor used for outbound logs
+func (s *LogSettings) Redactor() *Redactor {
+	return s.redactor
+}
+
+// logDecision is the per-call logging decision
+type logDecision struct {
+	enabled   bool // Log failures
+	sampled   bool // Log successes too
+	bodies    bool
+	debug     bool
+	maxBodies int
+}
+
+// decide makes the logging decision for one call; all attempts of the call share it
+func (s *LogSettings) decide(service string) logDecision {
+	if s == nil {
+		return logDecision{}
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.debug[service] {
+		return logDecision{enabled: true, sampled: true, bodies: true, debug: true, maxBodies: s.maxBodyBytes}
+	}
+	if !s.enabled {
+		return logDecision{}
+	}
+	return logDecision{
+		enabled:   true,
+		sampled:   s.sampleRate > 0 && rand.Float64() < s.sampleRate,
+		bodies:    s.logBodies,
+		maxBodies: s.maxBodyBytes,
+	}
+}
+
+// loggingTransport writes structured logs for each outbound attempt
+type loggingTransport struct {
+	next         http.RoundTripper
+	service      string
+	settings     *LogSettings
+	logger       *zap.Logger
+	breakerState func() string
+}
+
+// newLoggingTransport wraps next with outbound logging
+func newLoggingTransport(next http.RoundTripper, service string, settings *LogSettings, logger *zap.Logger, breakerState func() string) *loggingTransport {
+	return &loggingTransport{
+		next:         next,
+		service:      service,
+		settings:     settings,
+		logger:       logger.Named("outbound"),
+		breakerState: breakerState,
+	}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	state := callStateFrom(req.Context())
+	var decision logDecision
+	attempt := 0
+	if state != nil {
+		decision = state.log
+		attempt = state.attempt
+	}
+	if !decision.enabled {
+		return t.next.RoundTrip(req)
+	}
+
+	// Capture the start of the request body without consuming it
+	var reqBody []byte
+	if decision.bodies && req.Body != nil && req.Body != http.NoBody {
+		reqBody, req.Body = peekBody(req.Body, decision.maxBodies)
+	}
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	duration := time.Since(start)
+
+	failed := err != nil || resp.StatusCode >= 400
+	if !failed && !decision.sampled {
+		return resp, err
+	}
+
+	redactor := t.settings.Redactor()
+	fields := []zap.Field{
+		zap.String("integration", t.service),
+		zap.String("method", req.Method),
+		zap.String("url", redactor.URL(req.URL)),
+		zap.Int("attempt", attempt),
+		zap.String("breaker_state", t.breakerState()),
+		zap.Duration("duration", duration),
+		zap.Any("request_headers", redactor.Header(req.Header)),
+	}
+	if reqBody != nil {
+		fields = append(fields, zap.ByteString("request_body", redactor.Body(req.Header.Get("Content-Type"), reqBody)))
+	}
+
+	if err != nil {
+		fields = append(fields, zap.Error(err))
+	} else {
+		fields = append(fields,
+			zap.Int("status", resp.StatusCode),
+			zap.Any("response_headers", redactor.Header(resp.Header)),
+		)
+		if decision.bodies {
+			var respBody []byte
+			respBody, resp.Body = peekBody(resp.Body, decision.maxBodies)
+			fields = append(fields, zap.ByteString("response_body", redactor.Body(resp.Header.Get("Content-Type"), respBody)))
+		}
+	}
+
+	switch {
+	case failed:
+		t.logger.Warn("outbound request", fields...)
+	case decision.debug:
+		t.logger.Info("outbound request (debug)", fields...)
+	default:
+		t.logger.Info("outbound request", fields...)
+	}
+
+	return resp, err
+}
+
+// peekBody reads up to limit bytes and returns them with a body that still
+// yields the full content
+func peekBody(body io.ReadCloser, limit int) ([]byte, io.ReadCloser) {
+	prefix, _ := io.ReadAll(io.LimitReader(body, int64(limit)))
+	return prefix, struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(prefix), body), body}
+}
@@ -0,0 +1,117 @@
+package httpclient
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Quota scopes
+const (
+	QuotaScopeApp  = "app"  // Shared by every call the integration makes
+	QuotaScopeUser = "user" // Counted separately for each user (see WithQuotaUser)
+)
+
+// defaultQuotaUser is the user of calls made with the integration's own token
+const defaultQuotaUser = "default"
+
+// QuotaConfig is a provider request quota over a sliding window,
+// e.g. 100 requests per minute per user
+type QuotaConfig struct {
+	Name   string        // Identifies the quota in keys and stats, e.g. "per_user"
+	Scope  string        // app or user
+	Limit  int           // Requests allowed per window
+	Window time.Duration // Length of the sliding window
+}
+
+// QuotaWindow is one quota counter a call has to fit into
+type QuotaWindow struct {
+	Key    string
+	Limit  int
+	Window time.Duration
+}
+
+// QuotaResult is the outcome of taking a request from a set of windows
+type QuotaResult struct {
+	Allowed    bool
+	RetryAfter time.Duration // When denied: how long until every window has room
+	Exceeded   int           // When denied: index of the window that is full
+	Remaining  []int         // Requests left in each window after this call
+}
+
+// QuotaStore counts requests against quota windows. A request is taken
+// from all windows or from none of them.
+type QuotaStore interface {
+	Take(ctx context.Context, windows []QuotaWindow) (QuotaResult, error)
+}
+
+// quotaUserCtxKey carries the user a call is made for
+type quotaUserCtxKey struct{}
+
+// WithQuotaUser makes calls count against the user's quotas instead of those of
+// the integration's own user. Use it when calling with a tenant's or user's token.
+func WithQuotaUser(ctx context.Context, user string) context.Context {
+	return context.WithValue(ctx, quotaUserCtxKey{}, user)
+}
+
+// quotaUser returns the user a call is made for
+func quotaUser(ctx context.Context) string {
+	if user, ok := ctx.Value(quotaUserCtxKey{}).(string); ok && user != "" {
+		return user
+	}
+	return defaultQuotaUser
+}
+
+// MemoryQuotaStore keeps quota windows in process memory. Each replica then
+// has its own budget, so it is only suitable for a single instance or as a
+// fallback when the shared store is unavailable.
+type MemoryQuotaStore struct {
+	mu      sync.Mutex
+	windows map[string][]time.Time
+}
+
+// NewMemoryQuotaStore creates a new in-memory quota store
+func NewMemoryQuotaStore() *MemoryQuotaStore {
+	return &MemoryQuotaStore{windows: make(map[string][]time.Time)}
+}
+
+// Take implements QuotaStore
+func (s *MemoryQuotaStore) Take(_ context.Context, windows []QuotaWindow) (QuotaResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	result := QuotaResult{Allowed: true, Exceeded: -1, Remaining: make([]int, len(windows))}
+
+	for i, w := range windows {
+		// Drop requests that have left the window
+		calls := s.windows[w.Key]
+		start := 0
+		for start < len(calls) && !calls[start].After(now.Add(-w.Window)) {
+			start++
+		}
+		calls = calls[start:]
+		s.windows[w.Key] = calls
+
+		result.Remaining[i] = w.Limit - len(calls)
+		if len(calls) >= w.Limit {
+			// Room opens when the oldest request that keeps the window full expires
+			wait := calls[len(calls)-w.Limit].Add(w.Window).Sub(now)
+			if !result.Allowed && wait <= result.RetryAfter {
+				continue
+			}
+			result.Allowed = false
+			result.RetryAfter = wait
+			result.Exceeded = i
+		}
+	}
+	if !result.Allowed {
+		return result, nil
+	}
+
+	for i, w := range windows {
+		s.windows[w.Key] = append(s.windows[w.Key], now)
+		result.Remaining[i]--
+	}
+	return result, nil
+}
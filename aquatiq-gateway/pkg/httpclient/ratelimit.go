@@ -0,0 +1,417 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// ErrRateLimited is returned (wrapped in a *RateLimitError) when a call would
+// have to wait too long for the integration's outbound rate limit
+var ErrRateLimited = errors.New("outbound rate limit exceeded")
+
+// RateLimitConfig keeps an integration within the provider's request quota
+type RateLimitConfig struct {
+	RequestsPerSecond float64       // Sustained rate (0 = unlimited)
+	Burst             int           // Requests allowed at once (defaults to 1)
+	MaxWait           time.Duration // Longest a call waits for its turn (0 = until context is done)
+	MaxQueue          int           // Calls that may wait for quota at once (0 = unlimited)
+	Quotas            []QuotaConfig // Provider quotas per app or user
+	App               string        // Identifies the app (OAuth client) the quotas are counted for
+	Store             QuotaStore    // Shares quota usage between replicas (defaults to process memory)
+}
+
+// RateLimitError reports that a call was rejected by the outbound rate limit
+type RateLimitError struct {
+	Service string
+	Quota   string        // The quota that was exhausted, if any
+	Wait    time.Duration // How long the call would have had to wait
+}
+
+// Error implements the error interface
+func (e *RateLimitError) Error() string {
+	if e.Quota != "" {
+		return fmt.Sprintf("%s: %s (quota %s, would wait %s)", e.Service, ErrRateLimited, e.Quota, e.Wait.Round(time.Millisecond))
+	}
+	if e.Wait == 0 {
+		return fmt.Sprintf("%s: %s (too many calls waiting)", e.Service, ErrRateLimited)
+	}
+	return fmt.Sprintf("%s: %s (would wait %s)", e.Service, ErrRateLimited, e.Wait.Round(time.Millisecond))
+}
+
+// Is makes errors.Is(err, ErrRateLimited) match
+func (e *RateLimitError) Is(target error) bool {
+	return target == ErrRateLimited
+}
+
+// StatusCode returns the HTTP status callers should respond with
+func (e *RateLimitError) StatusCode() int {
+	return http.StatusTooManyRequests
+}
+
+// IsRateLimited reports whether err was caused by the outbound rate limit
+func IsRateLimited(err error) bool {
+	return errors.Is(err, ErrRateLimited)
+}
+
+// RateLimiter paces outbound calls of a single integration. A token bucket
+// smooths bursts locally, while quotas count calls per app and per user in a
+// store shared by all replicas. Calls over budget queue until MaxWait.
+type RateLimiter struct {
+	service  string
+	limiter  *rate.Limiter // nil when only quotas are configured
+	maxWait  time.Duration
+	maxQueue int64
+	quotas   []QuotaConfig
+	app      string
+	store    QuotaStore
+	local    *MemoryQuotaStore // Used when the shared store fails
+
+	allowed   atomic.Uint64
+	delayed   atomic.Uint64
+	rejected  atomic.Uint64
+	totalWait atomic.Int64
+	waiting   atomic.Int64
+	fallbacks atomic.Uint64
+
+	mu       sync.Mutex
+	observed map[string]quotaObservation // Last known budget per window key
+}
+
+// quotaObservation is the remaining budget of a window seen by the last call
+type quotaObservation struct {
+	quota     int    // Index into quotas
+	subject   string // app or the user name
+	remaining int
+	at        time.Time
+}
+
+// RateLimitStats holds statistics for an outbound rate limiter
+type RateLimitStats struct {
+	RequestsPerSecond float64      `json:"requests_per_second"`
+	Burst             int          `json:"burst"`
+	Available         float64      `json:"available"`
+	Allowed           uint64       `json:"allowed"`
+	Delayed           uint64       `json:"delayed"`
+	Rejected          uint64       `json:"rejected"`
+	AvgWaitMs         int64        `json:"avg_wait_ms"`
+	Waiting           int64        `json:"waiting"`
+	Backend           string       `json:"backend,omitempty"`
+	Fallbacks         uint64       `json:"fallbacks,omitempty"`
+	Quotas            []QuotaStats `json:"quotas,omitempty"`
+}
+
+// QuotaStats holds the remaining budget of a quota. Budgets are as seen by
+// this replica's last call; windows it has not used recently are full.
+type QuotaStats struct {
+	Name          string         `json:"name"`
+	Scope         string         `json:"scope"`
+	Limit         int            `json:"limit"`
+	WindowSeconds float64        `json:"window_seconds"`
+	Remaining     map[string]int `json:"remaining"` // By app or user
+}
+
+// NewRateLimiter creates a new rate limiter; it returns nil when neither
+// RequestsPerSecond nor quotas are set
+func NewRateLimiter(service string, cfg RateLimitConfig) *RateLimiter {
+	quotas := make([]QuotaConfig, 0, len(cfg.Quotas))
+	for _, q := range cfg.Quotas {
+		if q.Limit > 0 && q.Window > 0 {
+			quotas = append(quotas, q)
+		}
+	}
+	if cfg.RequestsPerSecond <= 0 && len(quotas) == 0 {
+		return nil
+	}
+
+	l := &RateLimiter{
+		service:  service,
+		maxWait:  cfg.MaxWait,
+		maxQueue: int64(cfg.MaxQueue),
+		quotas:   quotas,
+		app:      cfg.App,
+		store:    cfg.Store,
+		local:    NewMemoryQuotaStore(),
+		observed: make(map[string]quotaObservation),
+	}
+	if l.app == "" {
+		l.app = "default"
+	}
+	if cfg.RequestsPerSecond > 0 {
+		if cfg.Burst <= 0 {
+			cfg.Burst = 1
+		}
+		l.limiter = rate.NewLimiter(rate.Limit(cfg.RequestsPerSecond), cfg.Burst)
+	}
+	return l
+}
+
+// Wait blocks until the call may be sent. Calls that would wait beyond
+// MaxWait or the context deadline are rejected straight away rather than queued.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	start := time.Now()
+	deadline, hasDeadline := ctx.Deadline()
+	if l.maxWait > 0 && (!hasDeadline || start.Add(l.maxWait).Before(deadline)) {
+		deadline, hasDeadline = start.Add(l.maxWait), true
+	}
+
+	// The rate token is reserved first so the quotas are not spent on a call
+	// the token bucket rejects, and given back when a quota rejects the call
+	reservation, err := l.reserveRate(deadline, hasDeadline)
+	if err != nil {
+		return err
+	}
+	quotaWaited, err := l.waitQuota(ctx, deadline, hasDeadline)
+	if err != nil {
+		if reservation != nil {
+			reservation.Cancel()
+		}
+		return err
+	}
+	rateWaited, err := waitReservation(ctx, reservation)
+	if err != nil {
+		return err
+	}
+
+	l.allowed.Add(1)
+	if rateWaited || quotaWaited {
+		l.delayed.Add(1)
+		l.totalWait.Add(int64(time.Since(start)))
+	}
+	return nil
+}
+
+// rateLimitTransport makes every attempt wait for the rate limit and quotas,
+// so retries, hedges and replays after a token refresh count like first
+// attempts. The first attempt waited in Client.Do already.
+type rateLimitTransport struct {
+	next    http.RoundTripper
+	limiter *RateLimiter
+}
+
+// newRateLimitTransport wraps next with the rate limiter; it returns next when there is no limit
+func newRateLimitTransport(next http.RoundTripper, limiter *RateLimiter) http.RoundTripper {
+	if limiter == nil {
+		return next
+	}
+	return &rateLimitTransport{next: next, limiter: limiter}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if state := callStateFrom(req.Context()); state != nil && state.admitted.Swap(false) {
+		return t.next.RoundTrip(req)
+	}
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+	return t.next.RoundTrip(req)
+}
+
+// reserveRate reserves a token from the local token bucket, rejecting the
+// call when the token comes too late; it returns nil when there is no bucket
+func (l *RateLimiter) reserveRate(deadline time.Time, hasDeadline bool) (*rate.Reservation, error) {
+	if l.limiter == nil {
+		return nil, nil
+	}
+
+	reservation := l.limiter.Reserve()
+	if delay := reservation.Delay(); hasDeadline && time.Until(deadline) < delay {
+		reservation.Cancel()
+		l.rejected.Add(1)
+		return nil, &RateLimitError{Service: l.service, Wait: delay}
+	}
+	return reservation, nil
+}
+
+// waitReservation waits until a reserved token may be used
+func waitReservation(ctx context.Context, reservation *rate.Reservation) (bool, error) {
+	if reservation == nil {
+		return false, nil
+	}
+	delay := reservation.Delay()
+	if delay == 0 {
+		return false, nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true, nil
+	case <-ctx.Done():
+		reservation.Cancel()
+		return false, ctx.Err()
+	}
+}
+
+// waitQuota takes the call from every quota window, queueing while one is full
+func (l *RateLimiter) waitQuota(ctx context.Context, deadline time.Time, hasDeadline bool) (bool, error) {
+	if len(l.quotas) == 0 {
+		return false, nil
+	}
+
+	user := quotaUser(ctx)
+	windows := l.windows(user)
+	queued := false
+
+	for {
+		result, err := l.take(ctx, windows)
+		if err != nil {
+			return queued, err
+		}
+		l.observe(windows, user, result)
+		if result.Allowed {
+			return queued, nil
+		}
+
+		quota := l.quotas[result.Exceeded].Name
+		if hasDeadline && time.Until(deadline) < result.RetryAfter {
+			l.rejected.Add(1)
+			return queued, &RateLimitError{Service: l.service, Quota: quota, Wait: result.RetryAfter}
+		}
+
+		if !queued {
+			if l.maxQueue > 0 && l.waiting.Load() >= l.maxQueue {
+				l.rejected.Add(1)
+				return false, &RateLimitError{Service: l.service, Quota: quota}
+			}
+			queued = true
+			l.waiting.Add(1)
+			defer l.waiting.Add(-1)
+		}
+
+		// Spread waiters out so they do not all retry at the same instant
+		wait := result.RetryAfter + time.Duration(rand.Int64N(int64(quotaRetryJitter)))
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return queued, ctx.Err()
+		}
+	}
+}
+
+// quotaRetryJitter is the most a queued call adds to its wait for quota
+const quotaRetryJitter = 25 * time.Millisecond
+
+// windows returns the quota windows a call for user counts against
+func (l *RateLimiter) windows(user string) []QuotaWindow {
+	windows := make([]QuotaWindow, len(l.quotas))
+	for i, q := range l.quotas {
+		key := "quota:" + l.service + ":" + l.app + ":" + q.Name
+		if q.Scope == QuotaScopeUser {
+			key += ":" + user
+		}
+		windows[i] = QuotaWindow{Key: key, Limit: q.Limit, Window: q.Window}
+	}
+	return windows
+}
+
+// take counts the call in the shared store, falling back to process memory when it fails
+func (l *RateLimiter) take(ctx context.Context, windows []QuotaWindow) (QuotaResult, error) {
+	if l.store != nil {
+		result, err := l.store.Take(ctx, windows)
+		if err == nil {
+			return result, nil
+		}
+		if ctx.Err() != nil {
+			return QuotaResult{}, ctx.Err()
+		}
+		l.fallbacks.Add(1)
+	}
+	return l.local.Take(ctx, windows)
+}
+
+// observe records the remaining budget of each window
+func (l *RateLimiter) observe(windows []QuotaWindow, user string, result QuotaResult) {
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for i, w := range windows {
+		if i >= len(result.Remaining) {
+			break
+		}
+		subject := QuotaScopeApp
+		if l.quotas[i].Scope == QuotaScopeUser {
+			subject = user
+		}
+		l.observed[w.Key] = quotaObservation{quota: i, subject: subject, remaining: max(result.Remaining[i], 0), at: now}
+	}
+
+	// Windows unused for a full period are full again and need not be tracked
+	for key, o := range l.observed {
+		if now.Sub(o.at) >= l.quotas[o.quota].Window {
+			delete(l.observed, key)
+		}
+	}
+}
+
+// Stats returns rate limiter statistics
+func (l *RateLimiter) Stats() RateLimitStats {
+	if l == nil {
+		return RateLimitStats{}
+	}
+
+	stats := RateLimitStats{
+		Allowed:  l.allowed.Load(),
+		Delayed:  l.delayed.Load(),
+		Rejected: l.rejected.Load(),
+		Waiting:  l.waiting.Load(),
+	}
+	if l.limiter != nil {
+		stats.RequestsPerSecond = float64(l.limiter.Limit())
+		stats.Burst = l.limiter.Burst()
+		stats.Available = l.limiter.Tokens()
+	}
+	if stats.Delayed > 0 {
+		stats.AvgWaitMs = time.Duration(l.totalWait.Load() / int64(stats.Delayed)).Milliseconds()
+	}
+
+	if len(l.quotas) > 0 {
+		stats.Backend = "local"
+		if l.store != nil {
+			stats.Backend = "shared"
+		}
+		stats.Fallbacks = l.fallbacks.Load()
+
+		stats.Quotas = make([]QuotaStats, len(l.quotas))
+		for i, q := range l.quotas {
+			stats.Quotas[i] = QuotaStats{
+				Name:          q.Name,
+				Scope:         q.Scope,
+				Limit:         q.Limit,
+				WindowSeconds: q.Window.Seconds(),
+				Remaining:     make(map[string]int),
+			}
+		}
+
+		now := time.Now()
+		l.mu.Lock()
+		for _, o := range l.observed {
+			if now.Sub(o.at) < l.quotas[o.quota].Window {
+				stats.Quotas[o.quota].Remaining[o.subject] = o.remaining
+			}
+		}
+		l.mu.Unlock()
+	}
+	return stats
+}
@@ -0,0 +1,169 @@
+package httpclient
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// redactedValue replaces sensitive values
+const redactedValue = "[REDACTED]"
+
+// unparseableBody replaces JSON and form bodies that cannot be parsed, e.g.
+// ones truncated for logging, since their fields cannot be redacted
+const unparseableBody = "[unparseable body redacted]"
+
+// DefaultRedactHeaders are headers that never leave the client unredacted
+var DefaultRedactHeaders = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"Cookie",
+	"Set-Cookie",
+	"X-API-Key",
+}
+
+// DefaultRedactFields are JSON, form and query fields holding secrets or PII
+var DefaultRedactFields = []string{
+	"access_token",
+	"refresh_token",
+	"id_token",
+	"client_secret",
+	"code",
+	"code_verifier",
+	"password",
+	"token",
+	"api_key",
+	"email",
+	"phone",
+	"mobile",
+	"ssn",
+	"personal_number",
+	"birth_date",
+}
+
+// Redactor removes credentials and PII from headers, URLs and bodies
+type Redactor struct {
+	headers map[string]bool
+	fields  map[string]bool
+}
+
+// NewRedactor creates a redactor; empty lists fall back to the defaults
+func NewRedactor(headers, fields []string) *Redactor {
+	if len(headers) == 0 {
+		headers = DefaultRedactHeaders
+	}
+	if len(fields) == 0 {
+		fields = DefaultRedactFields
+	}
+
+	r := &Redactor{
+		headers: make(map[string]bool, len(headers)),
+		fields:  make(map[string]bool, len(fields)),
+	}
+	for _, h := range headers {
+		r.headers[http.CanonicalHeaderKey(h)] = true
+	}
+	for _, f := range fields {
+		r.fields[normalizeField(f)] = true
+	}
+	return r
+}
+
+// Header returns a copy of the headers with sensitive values redacted
+func (r *Redactor) Header(header http.Header) http.Header {
+	redacted := make(http.Header, len(header))
+	for name, values := range header {
+		if r.headers[http.CanonicalHeaderKey(name)] {
+			redacted[name] = []string{redactedValue}
+			continue
+		}
+		redacted[name] = values
+	}
+	return redacted
+}
+
+// URL returns the URL with sensitive query parameters redacted
+func (r *Redactor) URL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+	if u.RawQuery == "" && u.User == nil {
+		return u.String()
+	}
+
+	clean := *u
+	clean.User = nil
+	clean.RawQuery = r.values(u.Query()).Encode()
+	return clean.String()
+}
+
+// Body returns the body with sensitive fields redacted (JSON and form bodies).
+// It fails closed: bodies that do not parse are replaced as a whole.
+func (r *Redactor) Body(contentType string, body []byte) []byte {
+	if len(body) == 0 {
+		return body
+	}
+
+	switch {
+	case strings.Contains(contentType, "json"):
+		var doc interface{}
+		if err := json.Unmarshal(body, &doc); err != nil {
+			return []byte(unparseableBody)
+		}
+		redacted, err := json.Marshal(r.value(doc))
+		if err != nil {
+			return []byte(unparseableBody)
+		}
+		return redacted
+	case strings.Contains(contentType, "application/x-www-form-urlencoded"):
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return []byte(unparseableBody)
+		}
+		return []byte(r.values(values).Encode())
+	default:
+		return body
+	}
+}
+
+// value walks a decoded JSON document and redacts matching keys
+func (r *Redactor) value(v interface{}) interface{} {
+	switch typed := v.(type) {
+	case map[string]interface{}:
+		for key, child := range typed {
+			if r.fields[normalizeField(key)] {
+				typed[key] = redactedValue
+			} else {
+				typed[key] = r.value(child)
+			}
+		}
+		return typed
+	case []interface{}:
+		for i, child := range typed {
+			typed[i] = r.value(child)
+		}
+		return typed
+	default:
+		return v
+	}
+}
+
+// values redacts matching form or query parameters
+func (r *Redactor) values(values url.Values) url.Values {
+	redacted := make(url.Values, len(values))
+	for key, vals := range values {
+		if r.fields[normalizeField(key)] {
+			redacted[key] = []string{redactedValue}
+			continue
+		}
+		redacted[key] = vals
+	}
+	return redacted
+}
+
+// normalizeField makes field matching independent of case and separators
+// (so "access_token", "accessToken" and "AccessToken" all match)
+func normalizeField(name string) string {
+	return strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(name))
+}
@@ -0,0 +1,22 @@
+package httpclient
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+// RequestIDHeader carries the ID of the gateway request an outbound call is made for
+const RequestIDHeader = "X-Request-ID"
+
+// applyRequestID passes on the request ID that chi's RequestID middleware or
+// the gateway's gRPC interceptors put in the context, so provider logs can be
+// correlated with audit events
+func applyRequestID(req *http.Request) {
+	if req.Header.Get(RequestIDHeader) != "" {
+		return
+	}
+	if requestID := middleware.GetReqID(req.Context()); requestID != "" {
+		req.Header.Set(RequestIDHeader, requestID)
+	}
+}
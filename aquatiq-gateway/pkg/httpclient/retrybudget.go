@@ -0,0 +1,118 @@
+package httpclient
+
+import (
+	"sync"
+	"time"
+)
+
+// RetryBudgetConfig caps retries to a share of regular traffic
+type RetryBudgetConfig struct {
+	Ratio        float64 // Retries earned per request (0.1 = retries may add 10% load; 0 = disabled)
+	MinPerSecond float64 // Retries always allowed per second, even with little traffic
+	MaxTokens    float64 // Maximum retries that can be saved up (default 10)
+}
+
+// RetryBudget is a token bucket shared by all requests of an integration.
+// Every request deposits Ratio tokens and every retry or hedge withdraws one,
+// so during an outage retries cannot multiply load on the upstream.
+type RetryBudget struct {
+	mu        sync.Mutex
+	tokens    float64
+	ratio     float64
+	minRate   float64
+	maxTokens float64
+	lastFill  time.Time
+
+	requests uint64
+	retries  uint64
+	denied   uint64
+}
+
+// RetryBudgetStats holds statistics for a retry budget
+type RetryBudgetStats struct {
+	Ratio     float64 `json:"ratio"`
+	Available float64 `json:"available"`
+	Requests  uint64  `json:"requests"`
+	Retries   uint64  `json:"retries"`
+	Denied    uint64  `json:"denied"`
+}
+
+// NewRetryBudget creates a new retry budget; it returns nil when Ratio is not set
+func NewRetryBudget(cfg RetryBudgetConfig) *RetryBudget {
+	if cfg.Ratio <= 0 {
+		return nil
+	}
+	if cfg.MaxTokens <= 0 {
+		cfg.MaxTokens = 10
+	}
+
+	return &RetryBudget{
+		tokens:    cfg.MaxTokens,
+		ratio:     cfg.Ratio,
+		minRate:   cfg.MinPerSecond,
+		maxTokens: cfg.MaxTokens,
+		lastFill:  time.Now(),
+	}
+}
+
+// Deposit records a first attempt and earns retry tokens
+func (b *RetryBudget) Deposit() {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill()
+	b.requests++
+	b.tokens = min(b.tokens+b.ratio, b.maxTokens)
+}
+
+// Withdraw spends one token for a retry; it returns false when the budget is exhausted
+func (b *RetryBudget) Withdraw() bool {
+	if b == nil {
+		return true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill()
+	if b.tokens < 1 {
+		b.denied++
+		return false
+	}
+
+	b.tokens--
+	b.retries++
+	return true
+}
+
+// refill adds the time-based minimum allowance (caller must hold the lock)
+func (b *RetryBudget) refill() {
+	now := time.Now()
+	if b.minRate > 0 {
+		b.tokens = min(b.tokens+now.Sub(b.lastFill).Seconds()*b.minRate, b.maxTokens)
+	}
+	b.lastFill = now
+}
+
+// Stats returns retry budget statistics
+func (b *RetryBudget) Stats() RetryBudgetStats {
+	if b == nil {
+		return RetryBudgetStats{}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill()
+	return RetryBudgetStats{
+		Ratio:     b.ratio,
+		Available: b.tokens,
+		Requests:  b.requests,
+		Retries:   b.retries,
+		Denied:    b.denied,
+	}
+}
@@ -0,0 +1,92 @@
+package httpclient
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+)
+
+// Payload directions
+const (
+	DirectionRequest  = "request"
+	DirectionResponse = "response"
+)
+
+// Payload is a request or response body of an integration call
+type Payload struct {
+	Service    string
+	Method     string
+	Path       string // URL path of the call
+	Direction  string // request or response
+	StatusCode int    // Response status; 0 for requests
+	Body       []byte
+}
+
+// PayloadValidator checks integration payloads against schemas (implemented
+// by schema.Registry). It reports failures itself; a returned error rejects
+// the call, so only return one when invalid payloads must not pass.
+type PayloadValidator interface {
+	Validate(ctx context.Context, payload Payload) error
+}
+
+// validateRequest checks the body of a write before it is sent
+func (c *Client) validateRequest(req *http.Request) error {
+	if c.config.Validator == nil || isSafeMethod(req.Method) {
+		return nil
+	}
+
+	body, ok := copyBody(req)
+	if !ok || len(body) == 0 {
+		return nil
+	}
+	return c.config.Validator.Validate(req.Context(), Payload{
+		Service:   c.config.ServiceName,
+		Method:    req.Method,
+		Path:      req.URL.Path,
+		Direction: DirectionRequest,
+		Body:      body,
+	})
+}
+
+// validateResponse checks the body of a successful response
+func (c *Client) validateResponse(req *http.Request, resp *http.Response, body []byte) error {
+	if c.config.Validator == nil || len(body) == 0 {
+		return nil
+	}
+	return c.config.Validator.Validate(req.Context(), Payload{
+		Service:    c.config.ServiceName,
+		Method:     req.Method,
+		Path:       req.URL.Path,
+		Direction:  DirectionResponse,
+		StatusCode: resp.StatusCode,
+		Body:       body,
+	})
+}
+
+// Helper functions
+
+// copyBody returns a copy of a request body; the request body stays readable
+func copyBody(req *http.Request) ([]byte, bool) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, true
+	}
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			defer body.Close()
+			data, err := io.ReadAll(body)
+			return data, err == nil
+		}
+	}
+
+	data, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, false
+	}
+	req.Body = io.NopCloser(bytes.NewReader(data))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(data)), nil
+	}
+	return data, true
+}